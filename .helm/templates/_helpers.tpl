@@ -158,3 +158,91 @@ Generate the job template viewer cluster role name
 {{- printf "%s-backfill-request-editor" (include "app.fullname" .) }}
 {{- end }}
 {{- end }}
+
+{{/*
+Generate the backfill request status editor cluster role name
+*/}}
+{{- define "app.clusteRole.backfillRequestStatusEditor" -}}
+{{- if .Values.rbac.clusterRole.backfillRequestStatusEditor.nameOverride }}
+{{- .Values.rbac.clusterRole.backfillRequestStatusEditor.nameOverride }}
+{{- else }}
+{{- printf "%s-backfill-request-status-editor" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the namespace viewer cluster role name
+*/}}
+{{- define "app.clusteRole.namespaceViewer" -}}
+{{- if .Values.rbac.clusterRole.namespaceViewer.nameOverride }}
+{{- .Values.rbac.clusterRole.namespaceViewer.nameOverride }}
+{{- else }}
+{{- printf "%s-namespace-viewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the secret viewer cluster role name
+*/}}
+{{- define "app.clusteRole.secretViewer" -}}
+{{- if .Values.rbac.clusterRole.secretViewer.nameOverride }}
+{{- .Values.rbac.clusterRole.secretViewer.nameOverride }}
+{{- else }}
+{{- printf "%s-secret-viewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the config map viewer cluster role name
+*/}}
+{{- define "app.clusteRole.configMapViewer" -}}
+{{- if .Values.rbac.clusterRole.configMapViewer.nameOverride }}
+{{- .Values.rbac.clusterRole.configMapViewer.nameOverride }}
+{{- else }}
+{{- printf "%s-config-map-viewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the service account viewer cluster role name
+*/}}
+{{- define "app.clusteRole.serviceAccountViewer" -}}
+{{- if .Values.rbac.clusterRole.serviceAccountViewer.nameOverride }}
+{{- .Values.rbac.clusterRole.serviceAccountViewer.nameOverride }}
+{{- else }}
+{{- printf "%s-service-account-viewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the custom resource definition viewer cluster role name
+*/}}
+{{- define "app.clusteRole.crdViewer" -}}
+{{- if .Values.rbac.clusterRole.crdViewer.nameOverride }}
+{{- .Values.rbac.clusterRole.crdViewer.nameOverride }}
+{{- else }}
+{{- printf "%s-crd-viewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the backfill request approver cluster role name
+*/}}
+{{- define "app.clusteRole.backfillRequestApprover" -}}
+{{- if .Values.rbac.clusterRole.backfillRequestApprover.nameOverride }}
+{{- .Values.rbac.clusterRole.backfillRequestApprover.nameOverride }}
+{{- else }}
+{{- printf "%s-backfill-request-approver" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
+
+{{/*
+Generate the access reviewer cluster role name
+*/}}
+{{- define "app.clusteRole.accessReviewer" -}}
+{{- if .Values.rbac.clusterRole.accessReviewer.nameOverride }}
+{{- .Values.rbac.clusterRole.accessReviewer.nameOverride }}
+{{- else }}
+{{- printf "%s-access-reviewer" (include "app.fullname" .) }}
+{{- end }}
+{{- end }}
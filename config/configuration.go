@@ -1,7 +1,9 @@
 package config
 
 import (
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	"github.com/SneaksAndData/arcane-operator/services/health"
+	"github.com/SneaksAndData/arcane-operator/services/webhooks"
 	"github.com/SneaksAndData/arcane-operator/telemetry"
 )
 
@@ -16,4 +18,10 @@ type AppConfig struct {
 
 	// Telemetry holds the telemetry configuration settings.
 	Telemetry telemetry.Config `mapstructure:"telemetry,omitempty"`
+
+	// Webhook holds the configuration of the admission webhook server.
+	Webhook webhooks.WebhookConfig `mapstructure:"webhook,omitempty"`
+
+	// StreamController holds the default settings of the stream controllers, overridable for each StreamClass.
+	StreamController stream.ControllerConfig `mapstructure:"stream-controller,omitempty"`
 }
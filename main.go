@@ -11,18 +11,23 @@ import (
 	"github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/pkg/signals"
 	"github.com/SneaksAndData/arcane-operator/services"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/backfill_campaign"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/backfill_request"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/backfill_schedule"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream_class"
 	"github.com/SneaksAndData/arcane-operator/services/health"
 	"github.com/SneaksAndData/arcane-operator/services/job/job_builder"
 	"github.com/SneaksAndData/arcane-operator/services/providers"
 	"github.com/SneaksAndData/arcane-operator/services/providers/hooks"
+	"github.com/SneaksAndData/arcane-operator/services/webhooks"
 	"github.com/SneaksAndData/arcane-operator/telemetry"
 	"github.com/go-logr/stdr"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 )
 
 var (
@@ -96,20 +101,57 @@ func main() {
 		panic(err)
 	}
 
+	// The approvals of backfill requests can only be trusted if the admission webhook verifies them
+	streamControllerConfig := appConfig.StreamController
+	streamControllerConfig.ApprovalsVerified = appConfig.Webhook.Enabled
+
 	controllerFactory := services.NewStreamControllerFactory(
 		mgr.GetClient(),
 		job_builder.NewDefaultJobBuilder(mgr.GetClient()),
 		mgr,
 		eventRecorder,
 		contracts.FromUnstructured,
+		streamControllerConfig,
 	)
-	err = stream_class.NewStreamClassReconciler(mgr.GetClient(), controllerFactory, reporter, eventRecorder).SetupWithManager(mgr)
+	err = stream_class.NewStreamClassReconciler(mgr.GetClient(), controllerFactory, reporter, eventRecorder, contracts.FromUnstructured).SetupWithManager(mgr)
 
 	if err != nil {
 		bootstrapLogger.V(0).Error(err, "unable to create controller", "controller", "StreamClass")
 		panic(err)
 	}
 
+	err = stream_class.NewStreamClassStatusReconciler(mgr.GetClient(), stream_class.DefaultStatusUpdateInterval).SetupWithManager(mgr)
+	if err != nil {
+		bootstrapLogger.V(0).Error(err, "unable to create controller", "controller", "StreamClassStatus")
+		panic(err)
+	}
+
+	err = backfill_campaign.NewBackfillCampaignReconciler(mgr.GetClient(), eventRecorder).SetupWithManager(mgr)
+	if err != nil {
+		bootstrapLogger.V(0).Error(err, "unable to create controller", "controller", "BackfillCampaign")
+		panic(err)
+	}
+
+	err = backfill_request.NewBackfillRequestReconciler(mgr.GetClient(), eventRecorder).SetupWithManager(mgr)
+	if err != nil {
+		bootstrapLogger.V(0).Error(err, "unable to create controller", "controller", "BackfillRequest")
+		panic(err)
+	}
+
+	err = backfill_schedule.NewBackfillScheduleReconciler(mgr.GetClient(), eventRecorder, clock.RealClock{}).SetupWithManager(mgr)
+	if err != nil {
+		bootstrapLogger.V(0).Error(err, "unable to create controller", "controller", "BackfillSchedule")
+		panic(err)
+	}
+
+	if appConfig.Webhook.Enabled {
+		err = webhooks.NewBackfillRequestValidator(mgr.GetClient(), contracts.FromUnstructured).SetupWithManager(mgr)
+		if err != nil {
+			bootstrapLogger.V(0).Error(err, "unable to create webhook", "webhook", "BackfillRequest")
+			panic(err)
+		}
+	}
+
 	err = mgr.Start(ctx)
 	if errors.Is(err, context.Canceled) {
 		logger.V(0).Info("App stopped due to context cancellation")
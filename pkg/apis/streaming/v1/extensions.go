@@ -3,8 +3,10 @@ package v1
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/SneaksAndData/arcane-operator/services/job"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -53,6 +55,138 @@ func (in *StreamClass) MetricsTags() map[string]string {
 	}
 }
 
+// IsTerminal returns true if the backfill request cannot move to another phase
+func (p BackfillRequestPhase) IsTerminal() bool {
+	return p == BackfillRequestPhaseSucceeded || p == BackfillRequestPhaseFailed || p == BackfillRequestPhaseCancelled
+}
+
+const (
+	// BackfillApprovalRequiredLabel is the namespace label requiring approval for the backfills of streams in the namespace
+	BackfillApprovalRequiredLabel = "arcane/backfill-approval-required"
+
+	// BackfillApprovedByAnnotation is the backfill request annotation holding the name of the approver
+	BackfillApprovedByAnnotation = "arcane/backfill-approved-by"
+
+	// BackfillApproveVerb is the RBAC verb on backfillrequests a user needs to approve a backfill request
+	BackfillApproveVerb = "approve"
+
+	// OperatorApprover is the approver recorded for the backfill requests approved by the operator itself
+	OperatorApprover = "arcane-operator"
+)
+
+// BackfillApprovalRequired returns true if backfills of streams of this class in the given namespace must be approved
+func (in *StreamClass) BackfillApprovalRequired(namespace *corev1.Namespace) bool {
+	if in.Spec.BackfillApprovalRequired {
+		return true
+	}
+	return namespace != nil && namespace.Labels[BackfillApprovalRequiredLabel] == "true"
+}
+
+// ApprovedBy returns the approver set in the approval annotation of the backfill request, or an empty string
+func (in *BackfillRequest) ApprovedBy() string {
+	return in.Annotations[BackfillApprovedByAnnotation]
+}
+
+const (
+	defaultBackfillInitialBackoff = 30 * time.Second
+	defaultBackfillMaxBackoff     = 10 * time.Minute
+)
+
+// EffectiveRetryPolicy returns the retry policy of the backfill request, falling back to the policy of the stream class.
+// Backfills are not retried if neither defines a policy.
+func (in *BackfillRequest) EffectiveRetryPolicy(streamClass *StreamClass) BackfillRetryPolicy {
+	policy := BackfillRetryPolicy{MaxAttempts: 1}
+	switch {
+	case in.Spec.RetryPolicy != nil:
+		policy = *in.Spec.RetryPolicy
+	case streamClass != nil && streamClass.Spec.BackfillRetryPolicy != nil:
+		policy = *streamClass.Spec.BackfillRetryPolicy
+	}
+
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	return policy
+}
+
+// CanRetry returns true if a backfill that has failed the given number of times can be attempted again
+func (in BackfillRetryPolicy) CanRetry(failedAttempts int32) bool {
+	return failedAttempts < in.MaxAttempts
+}
+
+// Backoff returns the delay before the next attempt of a backfill that has failed the given number of times
+func (in BackfillRetryPolicy) Backoff(failedAttempts int32) time.Duration {
+	if failedAttempts < 1 {
+		return 0
+	}
+
+	backoff, maxBackoff := defaultBackfillInitialBackoff, defaultBackfillMaxBackoff
+	if in.InitialBackoff != nil {
+		backoff = in.InitialBackoff.Duration
+	}
+	if in.MaxBackoff != nil {
+		maxBackoff = in.MaxBackoff.Duration
+	}
+
+	for i := int32(1); i < failedAttempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+const (
+	// BackfillFailedCondition is the condition type reporting why a backfill request has failed
+	BackfillFailedCondition = "Failed"
+
+	// BackfillDeadlineExceededReason is the reason of a backfill request whose job ran longer than its maximum duration
+	BackfillDeadlineExceededReason = "DeadlineExceeded"
+
+	// BackfillInvalidRangeReason is the reason of a backfill request whose time range bounds are not ordered
+	BackfillInvalidRangeReason = "InvalidRange"
+)
+
+// EffectiveMaxDuration returns the maximum duration of a backfill job run of the request, falling back to the maximum
+// duration of the stream class. Zero means the backfill job can run indefinitely.
+func (in *BackfillRequest) EffectiveMaxDuration(streamClass *StreamClass) time.Duration {
+	switch {
+	case in == nil:
+		return 0
+	case in.Spec.MaxDuration != nil:
+		return in.Spec.MaxDuration.Duration
+	case streamClass != nil && streamClass.Spec.MaxBackfillDuration != nil:
+		return streamClass.Spec.MaxBackfillDuration.Duration
+	}
+	return 0
+}
+
+// Validate checks that the backfill time range bounds, if both set, are ordered
+func (in *BackfillRequestSpec) Validate() error {
+	if in.From != nil && in.To != nil && !in.From.Before(in.To) {
+		return fmt.Errorf("the lower bound %s must be before the upper bound %s", in.From, in.To)
+	}
+	return nil
+}
+
+// JobOverride returns the part of the backfill request spec passed to the backfill job. The settings used only by the
+// operator, such as the retry policy, are left out.
+func (in *BackfillRequestSpec) JobOverride() BackfillRequestSpec {
+	return BackfillRequestSpec{
+		StreamClass: in.StreamClass,
+		StreamId:    in.StreamId,
+		From:        in.From,
+		To:          in.To,
+		Parameters:  in.Parameters,
+	}
+}
+
+// Validate returns an error if the reported percentage is outside of the range accepted by the CRD.
+func (in *BackfillProgress) Validate() error {
+	if in.Percent < 0 || in.Percent > 100 {
+		return fmt.Errorf("the percentage %d must be between 0 and 100", in.Percent)
+	}
+	return nil
+}
+
 var _ job.ConfiguratorProvider = (*BackfillRequest)(nil)
 
 // JobConfigurator returns a JobConfigurator for the BackfillRequest
@@ -62,14 +196,48 @@ func (in *BackfillRequest) JobConfigurator() (job.Configurator, error) {
 			WithConfigurator(job.NewBackfillConfigurator(false)).
 			Build(), nil
 	}
+	if err := in.Spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid backfill request %s/%s: %w", in.Namespace, in.Name, err)
+	}
 	configurator := job.NewConfiguratorChainBuilder().
-		WithConfigurator(job.NewEnvironmentConfigurator(in.Spec, "OVERRIDE")).
+		WithConfigurator(job.NewEnvironmentConfigurator(in.Spec.JobOverride(), "OVERRIDE")).
 		WithConfigurator(job.NewBackfillConfigurator(true)).
 		WithConfigurator(job.NewBackfillStaticIdConfigurator(in.Name)).
+		WithConfigurator(job.NewPartitionConfigurator(in.Spec.Partitions)).
 		Build()
 	return configurator, nil
 }
 
+var _ job.ConfiguratorProvider = (*PodPolicy)(nil)
+
+// JobConfigurator returns a JobConfigurator enforcing the PodPolicy on a job
+func (in *PodPolicy) JobConfigurator() (job.Configurator, error) {
+	if in == nil {
+		return job.NewConfiguratorChainBuilder().Build(), nil
+	}
+	return job.NewPodPolicyConfigurator(in.jobPodPolicy()), nil
+}
+
+// Hash returns the hash sum recorded on the jobs the PodPolicy is enforced on, or an empty string if there is no policy
+func (in *PodPolicy) Hash() (string, error) {
+	if in == nil {
+		return "", nil
+	}
+	return in.jobPodPolicy().Hash()
+}
+
+func (in *PodPolicy) jobPodPolicy() job.PodPolicy {
+	return job.PodPolicy{
+		Tolerations:        in.Tolerations,
+		NodeSelector:       in.NodeSelector,
+		PriorityClassName:  in.PriorityClassName,
+		ServiceAccountName: in.ServiceAccountName,
+		ImagePullSecrets:   in.ImagePullSecrets,
+		Labels:             in.Labels,
+		Annotations:        in.Annotations,
+	}
+}
+
 func camelCaseToSnakeCase(input string) string {
 	var output strings.Builder
 	for i, ch := range input {
@@ -52,6 +52,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&StreamingJobTemplateList{},
 		&BackfillRequest{},
 		&BackfillRequestList{},
+		&BackfillCampaign{},
+		&BackfillCampaignList{},
+		&BackfillSchedule{},
+		&BackfillScheduleList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -2,7 +2,10 @@ package v1
 
 import (
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Phase represents the current phase of the stream class
@@ -17,6 +20,80 @@ const (
 	PhaseStopped Phase = "Stopped"
 )
 
+// DeletionPolicy defines what happens to the streams of a stream class when the stream class is deleted
+// +kubebuilder:validation:Enum=Orphan;SuspendStreams;DeleteWorkloads
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyOrphan leaves the streams and their jobs running without a controller
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+
+	// DeletionPolicySuspendStreams suspends the streams and removes their jobs
+	DeletionPolicySuspendStreams DeletionPolicy = "SuspendStreams"
+
+	// DeletionPolicyDeleteWorkloads removes the jobs of the streams and leaves the streams unchanged
+	DeletionPolicyDeleteWorkloads DeletionPolicy = "DeleteWorkloads"
+)
+
+// StreamBackend is the kind of workload running a stream
+// +kubebuilder:validation:Enum=BatchJob;CronJob
+type StreamBackend string
+
+const (
+	// StreamBackendBatchJob runs the stream as a long-running job
+	StreamBackendBatchJob StreamBackend = "BatchJob"
+
+	// StreamBackendCronJob runs the stream on a schedule
+	StreamBackendCronJob StreamBackend = "CronJob"
+)
+
+// ReferencedObjectKind is the kind of object referenced by a field of the stream spec
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type ReferencedObjectKind string
+
+const (
+	// ReferencedObjectKindSecret references a Secret
+	ReferencedObjectKindSecret ReferencedObjectKind = "Secret"
+
+	// ReferencedObjectKindConfigMap references a ConfigMap
+	ReferencedObjectKindConfigMap ReferencedObjectKind = "ConfigMap"
+)
+
+// SecretReference defines how the secret or config map referenced by a field of the stream spec is injected into
+// the jobs of the stream. Without keys and mount path, all keys of the object are injected as environment variables.
+type SecretReference struct {
+	// FieldName is the name of the field of the stream spec referencing the object by name
+	FieldName string `json:"fieldName"`
+
+	// Kind is the kind of the referenced object
+	// +kubebuilder:default=Secret
+	// +optional
+	Kind ReferencedObjectKind `json:"kind,omitempty"`
+
+	// Keys selects the keys of the object to inject. Without mount path, each key is injected as an environment
+	// variable, otherwise only the selected keys are mounted as files.
+	// +optional
+	Keys []SecretKeyReference `json:"keys,omitempty"`
+
+	// MountPath mounts the object as a read-only volume at the given path instead of environment variables
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Containers limits the injection to the containers with the given names, all containers if not set
+	// +optional
+	Containers []string `json:"containers,omitempty"`
+}
+
+// SecretKeyReference selects a key of a secret or config map
+type SecretKeyReference struct {
+	// Key is the key of the object
+	Key string `json:"key"`
+
+	// EnvName is the name of the environment variable holding the value of the key, the key itself if not set
+	// +optional
+	EnvName string `json:"envName,omitempty"`
+}
+
 // StreamClassSpec defines the desired state of a stream class to watch
 type StreamClassSpec struct {
 
@@ -34,6 +111,151 @@ type StreamClassSpec struct {
 
 	// SecretRefs is a list of fields to be extracted from the secret
 	SecretRefs []string `json:"secretRefs,omitempty"`
+
+	// SecretReferences is a list of fields of the stream spec referencing secrets or config maps, with the way they
+	// are injected into the jobs. Unlike SecretRefs, individual keys can be selected, the object can be mounted as a
+	// volume and the injection can be limited to some containers.
+	// +optional
+	SecretReferences []SecretReference `json:"secretReferences,omitempty"`
+
+	// IgnoreSecretRotation disables restarting the jobs of the streams of this class when the data of the secrets
+	// referenced by the streams changes
+	// +kubebuilder:default=false
+	IgnoreSecretRotation bool `json:"ignoreSecretRotation,omitempty"`
+
+	// BackfillRetryPolicy is the default retry policy for the backfills of streams of this class
+	// +optional
+	BackfillRetryPolicy *BackfillRetryPolicy `json:"backfillRetryPolicy,omitempty"`
+
+	// BackfillApprovalRequired indicates whether backfills of streams of this class must be approved before they start
+	// +kubebuilder:default=false
+	BackfillApprovalRequired bool `json:"backfillApprovalRequired,omitempty"`
+
+	// MaxBackfillDuration is the default maximum duration of a backfill job run for streams of this class
+	// +optional
+	MaxBackfillDuration *metav1.Duration `json:"maxBackfillDuration,omitempty"`
+
+	// NamespaceSelector selects the namespaces of the streams managed by this class, all namespaces if not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// StreamSelector selects the streams managed by this class by their labels, all streams if not set
+	// +optional
+	StreamSelector *metav1.LabelSelector `json:"streamSelector,omitempty"`
+
+	// Defaults are applied to the streams of this class that leave the corresponding settings empty
+	// +optional
+	Defaults *StreamDefaults `json:"defaults,omitempty"`
+
+	// PodPolicy is enforced on all jobs of the streams of this class on top of their job templates
+	// +optional
+	PodPolicy *PodPolicy `json:"podPolicy,omitempty"`
+
+	// Suspend suspends all streams of this class without changing the spec of the streams. When the class is resumed,
+	// each stream returns to the state defined by its own spec.
+	// +kubebuilder:default=false
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Controller tunes the reconciliation of the streams of this class, unset fields use the operator-wide defaults
+	// +optional
+	Controller *StreamControllerSettings `json:"controller,omitempty"`
+
+	// DeletionPolicy defines what happens to the streams of this class and their jobs when the class is deleted
+	// +kubebuilder:default=Orphan
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// StreamDefaults defines the settings used by the streams of a stream class that do not set them
+type StreamDefaults struct {
+	// StreamingJobTemplateRef is the StreamingJobTemplate used for the streaming jobs
+	// +optional
+	StreamingJobTemplateRef *corev1.ObjectReference `json:"streamingJobTemplateRef,omitempty"`
+
+	// BackfillJobTemplateRef is the StreamingJobTemplate used for the backfill jobs
+	// +optional
+	BackfillJobTemplateRef *corev1.ObjectReference `json:"backfillJobTemplateRef,omitempty"`
+
+	// Backend is the backend of the streams that do not define a streaming backend
+	// +optional
+	Backend StreamBackend `json:"backend,omitempty"`
+
+	// Schedule is the schedule of the streams running with the CronJob backend
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// PodPolicy defines the scheduling and metadata settings enforced on the jobs of a stream class.
+// Tolerations and image pull secrets are added to the ones of the job template. Node selector entries, labels and
+// annotations replace the entries of the job template with the same key. The priority class and the service account
+// replace the ones of the job template when set. Labels and annotations managed by the operator cannot be overridden.
+type PodPolicy struct {
+	// Tolerations are added to the pods of the jobs
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeSelector entries are added to the node selector of the pods of the jobs
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// PriorityClassName is the priority class of the pods of the jobs
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// ServiceAccountName is the service account of the pods of the jobs
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// ImagePullSecrets are added to the pods of the jobs
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// Labels are added to the jobs and their pods
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the jobs and their pods
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// StreamControllerSettings defines how the streams of a stream class are reconciled
+type StreamControllerSettings struct {
+	// MaxConcurrentReconciles is the maximum number of streams of the class reconciled concurrently
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentReconciles int32 `json:"maxConcurrentReconciles,omitempty"`
+
+	// RateLimiter limits how often the streams of the class are reconciled
+	// +optional
+	RateLimiter *RateLimiterSettings `json:"rateLimiter,omitempty"`
+
+	// ReconcileTimeout is the maximum duration of the reconciliation of a single stream
+	// +optional
+	ReconcileTimeout *metav1.Duration `json:"reconcileTimeout,omitempty"`
+}
+
+// RateLimiterSettings defines the rate limiter of a stream controller. A reconciliation is delayed by the larger of
+// the per-stream exponential backoff and the delay of the overall token bucket.
+type RateLimiterSettings struct {
+	// BaseDelay is the delay before retrying the reconciliation of a stream after the first failure, doubled for
+	// every following failure
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+
+	// MaxDelay is the upper limit of the delay before retrying the reconciliation of a stream
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// QPS is the overall number of reconciliations per second allowed by the token bucket
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	QPS int32 `json:"qps,omitempty"`
+
+	// Burst is the size of the token bucket
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
 }
 
 // StreamClassStatus defines the observed state of a stream class
@@ -41,6 +263,34 @@ type StreamClassStatus struct {
 	// Phase represents the current phase of the stream class
 	Phase Phase `json:"phase,omitempty"`
 
+	// ObservedGeneration is the generation of the stream class observed by the last status update
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ControllerStartTime is the time the stream controller for this class was started
+	// +optional
+	ControllerStartTime *metav1.Time `json:"controllerStartTime,omitempty"`
+
+	// ControllerRestarts is the number of times the stream controller for this class was restarted after a failure
+	// +optional
+	ControllerRestarts int32 `json:"controllerRestarts,omitempty"`
+
+	// LastControllerFailureTime is the time the stream controller for this class last exited with an error
+	// +optional
+	LastControllerFailureTime *metav1.Time `json:"lastControllerFailureTime,omitempty"`
+
+	// Streams is the number of streams of this class per stream phase
+	// +optional
+	Streams map[string]int32 `json:"streams,omitempty"`
+
+	// TotalStreams is the number of streams of this class
+	// +optional
+	TotalStreams int32 `json:"totalStreams,omitempty"`
+
+	// ActiveBackfills is the number of backfill requests for streams of this class that have not been completed
+	// +optional
+	ActiveBackfills int32 `json:"activeBackfills,omitempty"`
+
 	// Conditions represent the latest available observations
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -56,6 +306,10 @@ type StreamClassStatus struct {
 // +kubebuilder:printcolumn:name="KindRef",type=string,JSONPath=`.spec.kindRef`
 // +kubebuilder:printcolumn:name="PluralName",type=string,JSONPath=`.spec.pluralName`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="Streams",type=integer,JSONPath=`.status.totalStreams`
+// +kubebuilder:printcolumn:name="ActiveBackfills",type=integer,JSONPath=`.status.activeBackfills`
+// +kubebuilder:printcolumn:name="Restarts",type=integer,JSONPath=`.status.controllerRestarts`
 type StreamClass struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -96,7 +350,24 @@ type StreamingJobTemplateList struct {
 	Items           []StreamingJobTemplate `json:"items"`
 }
 
+// BackfillRetryPolicy defines how failed backfill jobs are retried
+type BackfillRetryPolicy struct {
+	// MaxAttempts is the maximum number of backfill job runs, including the first one
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+
+	// InitialBackoff is the delay before the first retry, doubled for every following retry
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the upper limit of the delay between retries
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
 // BackfillRequestSpec defines the desired state of a backfill request
+// +kubebuilder:validation:XValidation:rule="!has(self.from) || !has(self.to) || timestamp(self.from) < timestamp(self.to)",message="from must be before to"
 type BackfillRequestSpec struct {
 	// StreamClass is the name of the stream class to backfill
 	StreamClass string `json:"streamClass"`
@@ -107,12 +378,101 @@ type BackfillRequestSpec struct {
 	// Completed indicates whether the backfill request has been completed
 	// +kubebuilder:default=false
 	Completed bool `json:"completed,omitempty"`
+
+	// From is the optional lower bound (inclusive) of the time range to backfill
+	// +optional
+	From *metav1.Time `json:"from,omitempty"`
+
+	// To is the optional upper bound (exclusive) of the time range to backfill
+	// +optional
+	To *metav1.Time `json:"to,omitempty"`
+
+	// Parameters is an opaque, plugin-defined object passed to the backfill job as is
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+
+	// Cancel requests the operator to stop the backfill and return the stream to its normal streaming backend
+	// +kubebuilder:default=false
+	Cancel bool `json:"cancel,omitempty"`
+
+	// RetryPolicy overrides the backfill retry policy of the stream class for this request
+	// +optional
+	RetryPolicy *BackfillRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// MaxDuration overrides the maximum duration of a backfill job run of the stream class for this request
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// Partitions is the number of partitions of the backfill processed in parallel by the backfill job
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Partitions int32 `json:"partitions,omitempty"`
+}
+
+// BackfillRequestPhase represents the current phase of the backfill request
+// +kubebuilder:validation:Enum=AwaitingApproval;Running;Succeeded;Failed;Cancelled
+type BackfillRequestPhase string
+
+const (
+	BackfillRequestPhaseNew              BackfillRequestPhase = ""
+	BackfillRequestPhaseAwaitingApproval BackfillRequestPhase = "AwaitingApproval"
+	BackfillRequestPhaseRunning          BackfillRequestPhase = "Running"
+	BackfillRequestPhaseSucceeded        BackfillRequestPhase = "Succeeded"
+	BackfillRequestPhaseFailed           BackfillRequestPhase = "Failed"
+	BackfillRequestPhaseCancelled        BackfillRequestPhase = "Cancelled"
+)
+
+// BackfillProgress is the latest progress reported by a backfill job
+type BackfillProgress struct {
+	// Percent is the estimated share of the backfill that has been completed
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent int32 `json:"percent,omitempty"`
+
+	// Rows is the number of rows written by the backfill so far
+	// +optional
+	Rows int64 `json:"rows,omitempty"`
+
+	// Watermark is the position in the source data the backfill has reached, in a source-specific format
+	// +optional
+	Watermark string `json:"watermark,omitempty"`
+
+	// UpdateTime is the time the progress was reported by the backfill job
+	// +optional
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
 }
 
 // BackfillRequestStatus defines the observed state of a backfill request
 type BackfillRequestStatus struct {
 	// Phase represents the current phase of the backfill request
-	Phase Phase `json:"phase,omitempty"`
+	Phase BackfillRequestPhase `json:"phase,omitempty"`
+
+	// FailedAttempts is the number of backfill job runs that have failed
+	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// LastFailureTime is the time the last failed backfill job run was observed
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// LastFailedJobUID is the UID of the last failed backfill job run counted in FailedAttempts
+	// +optional
+	LastFailedJobUID types.UID `json:"lastFailedJobUid,omitempty"`
+
+	// ApprovedBy is the approver of the backfill request, if the backfill required an approval
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
+	// ApprovalTime is the time the approval of the backfill request was observed
+	// +optional
+	ApprovalTime *metav1.Time `json:"approvalTime,omitempty"`
+
+	// Progress is the latest progress reported by the backfill job
+	// +optional
+	Progress *BackfillProgress `json:"progress,omitempty"`
 
 	// Conditions represent the latest available observations
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -127,6 +487,9 @@ type BackfillRequestStatus struct {
 // +kubebuilder:printcolumn:name="StreamClass",type=string,JSONPath=`.spec.streamClass`
 // +kubebuilder:printcolumn:name="StreamId",type=string,JSONPath=`.spec.streamId`
 // +kubebuilder:printcolumn:name="Completed",type=string,JSONPath=`.spec.completed`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="FailedAttempts",type=integer,JSONPath=`.status.failedAttempts`
+// +kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress.percent`
 // +kubebuilder:selectablefield:JSONPath=.spec.completed
 // +kubebuilder:selectablefield:JSONPath=.spec.streamId
 type BackfillRequest struct {
@@ -144,3 +507,171 @@ type BackfillRequestList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []BackfillRequest `json:"items"`
 }
+
+// BackfillCampaignPhase represents the current phase of the backfill campaign
+// +kubebuilder:validation:Enum=Running;Completed
+type BackfillCampaignPhase string
+
+const (
+	BackfillCampaignPhaseNew       BackfillCampaignPhase = ""
+	BackfillCampaignPhaseRunning   BackfillCampaignPhase = "Running"
+	BackfillCampaignPhaseCompleted BackfillCampaignPhase = "Completed"
+)
+
+// BackfillCampaignSpec defines the desired state of a backfill campaign
+// +kubebuilder:validation:XValidation:rule="!has(self.from) || !has(self.to) || timestamp(self.from) < timestamp(self.to)",message="from must be before to"
+type BackfillCampaignSpec struct {
+	// StreamClass is the name of the stream class to backfill
+	StreamClass string `json:"streamClass"`
+
+	// Selector selects the streams to backfill by their labels. All streams of the class are selected if omitted
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Namespaces limits the campaign to the streams in the listed namespaces. All namespaces are used if omitted
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// MaxConcurrency is the maximum number of backfill requests of the campaign that can be active at the same time
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+
+	// From is copied to the backfill requests created by the campaign
+	// +optional
+	From *metav1.Time `json:"from,omitempty"`
+
+	// To is copied to the backfill requests created by the campaign
+	// +optional
+	To *metav1.Time `json:"to,omitempty"`
+
+	// Parameters is copied to the backfill requests created by the campaign
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// BackfillCampaignStatus defines the observed state of a backfill campaign
+type BackfillCampaignStatus struct {
+	// Phase represents the current phase of the backfill campaign
+	Phase BackfillCampaignPhase `json:"phase,omitempty"`
+
+	// Total is the number of streams selected by the campaign
+	Total int32 `json:"total"`
+
+	// Pending is the number of streams waiting for their backfill to start
+	Pending int32 `json:"pending"`
+
+	// Running is the number of streams being backfilled
+	Running int32 `json:"running"`
+
+	// Succeeded is the number of streams backfilled successfully
+	Succeeded int32 `json:"succeeded"`
+
+	// Failed is the number of streams whose backfill has failed or has been cancelled
+	Failed int32 `json:"failed"`
+
+	// Conditions represent the latest available observations
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// BackfillCampaign is the Schema for the backfill campaign API
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=bfc
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="StreamClass",type=string,JSONPath=`.spec.streamClass`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Running",type=integer,JSONPath=`.status.running`
+// +kubebuilder:printcolumn:name="Succeeded",type=integer,JSONPath=`.status.succeeded`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+type BackfillCampaign struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackfillCampaignSpec   `json:"spec,omitempty"`
+	Status BackfillCampaignStatus `json:"status,omitempty"`
+}
+
+// BackfillCampaignList contains a list of BackfillCampaign resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BackfillCampaignList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackfillCampaign `json:"items"`
+}
+
+// BackfillScheduleSpec defines the desired state of a backfill schedule
+// +kubebuilder:validation:XValidation:rule="has(self.streamId) != has(self.selector)",message="exactly one of streamId or selector must be set"
+type BackfillScheduleSpec struct {
+	// Schedule is the cron expression defining when backfills are requested
+	Schedule string `json:"schedule"`
+
+	// StreamClass is the name of the stream class to backfill
+	StreamClass string `json:"streamClass"`
+
+	// StreamId is the ID of the stream to backfill
+	// +optional
+	StreamId string `json:"streamId,omitempty"`
+
+	// Selector selects the streams to backfill in the namespace of the schedule by their labels
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Suspend stops the schedule from requesting new backfills
+	// +kubebuilder:default=false
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Parameters is copied to the backfill requests created by the schedule
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// BackfillScheduleStatus defines the observed state of a backfill schedule
+type BackfillScheduleStatus struct {
+	// LastScheduleTime is the time of the last scheduled run, whether it requested backfills or was skipped
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the time of the next scheduled run
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// Conditions represent the latest available observations
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// BackfillSchedule is the Schema for the backfill schedule API
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=bfs
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="StreamClass",type=string,JSONPath=`.spec.streamClass`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="LastSchedule",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="NextSchedule",type=date,JSONPath=`.status.nextScheduleTime`
+type BackfillSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackfillScheduleSpec   `json:"spec,omitempty"`
+	Status BackfillScheduleStatus `json:"status,omitempty"`
+}
+
+// BackfillScheduleList contains a list of BackfillSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BackfillScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackfillSchedule `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// BackfillCampaignSpecApplyConfiguration represents a declarative configuration of the BackfillCampaignSpec type for use
+// with apply.
+//
+// BackfillCampaignSpec defines the desired state of a backfill campaign
+type BackfillCampaignSpecApplyConfiguration struct {
+	// StreamClass is the name of the stream class to backfill
+	StreamClass *string `json:"streamClass,omitempty"`
+	// Selector selects the streams to backfill by their labels. All streams of the class are selected if omitted
+	Selector *metav1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// Namespaces limits the campaign to the streams in the listed namespaces. All namespaces are used if omitted
+	Namespaces []string `json:"namespaces,omitempty"`
+	// MaxConcurrency is the maximum number of backfill requests of the campaign that can be active at the same time
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+	// From is copied to the backfill requests created by the campaign
+	From *apismetav1.Time `json:"from,omitempty"`
+	// To is copied to the backfill requests created by the campaign
+	To *apismetav1.Time `json:"to,omitempty"`
+	// Parameters is copied to the backfill requests created by the campaign
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// BackfillCampaignSpecApplyConfiguration constructs a declarative configuration of the BackfillCampaignSpec type for use with
+// apply.
+func BackfillCampaignSpec() *BackfillCampaignSpecApplyConfiguration {
+	return &BackfillCampaignSpecApplyConfiguration{}
+}
+
+// WithStreamClass sets the StreamClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamClass field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithStreamClass(value string) *BackfillCampaignSpecApplyConfiguration {
+	b.StreamClass = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithSelector(value *metav1.LabelSelectorApplyConfiguration) *BackfillCampaignSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *BackfillCampaignSpecApplyConfiguration) WithNamespaces(values ...string) *BackfillCampaignSpecApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
+
+// WithMaxConcurrency sets the MaxConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConcurrency field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithMaxConcurrency(value int32) *BackfillCampaignSpecApplyConfiguration {
+	b.MaxConcurrency = &value
+	return b
+}
+
+// WithFrom sets the From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the From field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithFrom(value apismetav1.Time) *BackfillCampaignSpecApplyConfiguration {
+	b.From = &value
+	return b
+}
+
+// WithTo sets the To field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the To field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithTo(value apismetav1.Time) *BackfillCampaignSpecApplyConfiguration {
+	b.To = &value
+	return b
+}
+
+// WithParameters sets the Parameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parameters field is set to the value of the last call.
+func (b *BackfillCampaignSpecApplyConfiguration) WithParameters(value runtime.RawExtension) *BackfillCampaignSpecApplyConfiguration {
+	b.Parameters = &value
+	return b
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// BackfillCampaignStatusApplyConfiguration represents a declarative configuration of the BackfillCampaignStatus type for use
+// with apply.
+//
+// BackfillCampaignStatus defines the observed state of a backfill campaign
+type BackfillCampaignStatusApplyConfiguration struct {
+	// Phase represents the current phase of the backfill campaign
+	Phase *streamingv1.BackfillCampaignPhase `json:"phase,omitempty"`
+	// Total is the number of streams selected by the campaign
+	Total *int32 `json:"total,omitempty"`
+	// Pending is the number of streams waiting for their backfill to start
+	Pending *int32 `json:"pending,omitempty"`
+	// Running is the number of streams being backfilled
+	Running *int32 `json:"running,omitempty"`
+	// Succeeded is the number of streams backfilled successfully
+	Succeeded *int32 `json:"succeeded,omitempty"`
+	// Failed is the number of streams whose backfill has failed or has been cancelled
+	Failed *int32 `json:"failed,omitempty"`
+	// Conditions represent the latest available observations
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// BackfillCampaignStatusApplyConfiguration constructs a declarative configuration of the BackfillCampaignStatus type for use with
+// apply.
+func BackfillCampaignStatus() *BackfillCampaignStatusApplyConfiguration {
+	return &BackfillCampaignStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithPhase(value streamingv1.BackfillCampaignPhase) *BackfillCampaignStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithTotal sets the Total field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Total field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithTotal(value int32) *BackfillCampaignStatusApplyConfiguration {
+	b.Total = &value
+	return b
+}
+
+// WithPending sets the Pending field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pending field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithPending(value int32) *BackfillCampaignStatusApplyConfiguration {
+	b.Pending = &value
+	return b
+}
+
+// WithRunning sets the Running field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Running field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithRunning(value int32) *BackfillCampaignStatusApplyConfiguration {
+	b.Running = &value
+	return b
+}
+
+// WithSucceeded sets the Succeeded field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Succeeded field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithSucceeded(value int32) *BackfillCampaignStatusApplyConfiguration {
+	b.Succeeded = &value
+	return b
+}
+
+// WithFailed sets the Failed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failed field is set to the value of the last call.
+func (b *BackfillCampaignStatusApplyConfiguration) WithFailed(value int32) *BackfillCampaignStatusApplyConfiguration {
+	b.Failed = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *BackfillCampaignStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *BackfillCampaignStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -18,6 +18,11 @@ limitations under the License.
 
 package v1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
 // BackfillRequestSpecApplyConfiguration represents a declarative configuration of the BackfillRequestSpec type for use
 // with apply.
 //
@@ -29,6 +34,20 @@ type BackfillRequestSpecApplyConfiguration struct {
 	StreamId *string `json:"streamId,omitempty"`
 	// Completed indicates whether the backfill request has been completed
 	Completed *bool `json:"completed,omitempty"`
+	// From is the optional lower bound (inclusive) of the time range to backfill
+	From *metav1.Time `json:"from,omitempty"`
+	// To is the optional upper bound (exclusive) of the time range to backfill
+	To *metav1.Time `json:"to,omitempty"`
+	// Parameters is an opaque, plugin-defined object passed to the backfill job as is
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+	// Cancel requests the operator to stop the backfill and return the stream to its normal streaming backend
+	Cancel *bool `json:"cancel,omitempty"`
+	// RetryPolicy overrides the backfill retry policy of the stream class for this request
+	RetryPolicy *BackfillRetryPolicyApplyConfiguration `json:"retryPolicy,omitempty"`
+	// MaxDuration overrides the maximum duration of a backfill job run of the stream class for this request
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+	// Partitions is the number of partitions of the backfill processed in parallel by the backfill job
+	Partitions *int32 `json:"partitions,omitempty"`
 }
 
 // BackfillRequestSpecApplyConfiguration constructs a declarative configuration of the BackfillRequestSpec type for use with
@@ -60,3 +79,59 @@ func (b *BackfillRequestSpecApplyConfiguration) WithCompleted(value bool) *Backf
 	b.Completed = &value
 	return b
 }
+
+// WithFrom sets the From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the From field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithFrom(value metav1.Time) *BackfillRequestSpecApplyConfiguration {
+	b.From = &value
+	return b
+}
+
+// WithTo sets the To field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the To field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithTo(value metav1.Time) *BackfillRequestSpecApplyConfiguration {
+	b.To = &value
+	return b
+}
+
+// WithParameters sets the Parameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parameters field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithParameters(value runtime.RawExtension) *BackfillRequestSpecApplyConfiguration {
+	b.Parameters = &value
+	return b
+}
+
+// WithCancel sets the Cancel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cancel field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithCancel(value bool) *BackfillRequestSpecApplyConfiguration {
+	b.Cancel = &value
+	return b
+}
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithRetryPolicy(value *BackfillRetryPolicyApplyConfiguration) *BackfillRequestSpecApplyConfiguration {
+	b.RetryPolicy = value
+	return b
+}
+
+// WithMaxDuration sets the MaxDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDuration field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithMaxDuration(value metav1.Duration) *BackfillRequestSpecApplyConfiguration {
+	b.MaxDuration = &value
+	return b
+}
+
+// WithPartitions sets the Partitions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Partitions field is set to the value of the last call.
+func (b *BackfillRequestSpecApplyConfiguration) WithPartitions(value int32) *BackfillRequestSpecApplyConfiguration {
+	b.Partitions = &value
+	return b
+}
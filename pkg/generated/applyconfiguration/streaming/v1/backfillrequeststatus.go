@@ -20,7 +20,9 @@ package v1
 
 import (
 	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
-	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // BackfillRequestStatusApplyConfiguration represents a declarative configuration of the BackfillRequestStatus type for use
@@ -29,9 +31,21 @@ import (
 // BackfillRequestStatus defines the observed state of a backfill request
 type BackfillRequestStatusApplyConfiguration struct {
 	// Phase represents the current phase of the backfill request
-	Phase *streamingv1.Phase `json:"phase,omitempty"`
+	Phase *streamingv1.BackfillRequestPhase `json:"phase,omitempty"`
+	// FailedAttempts is the number of backfill job runs that have failed
+	FailedAttempts *int32 `json:"failedAttempts,omitempty"`
+	// LastFailureTime is the time the last failed backfill job run was observed
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+	// LastFailedJobUID is the UID of the last failed backfill job run counted in FailedAttempts
+	LastFailedJobUID *types.UID `json:"lastFailedJobUid,omitempty"`
+	// ApprovedBy is the approver of the backfill request, if the backfill required an approval
+	ApprovedBy *string `json:"approvedBy,omitempty"`
+	// ApprovalTime is the time the approval of the backfill request was observed
+	ApprovalTime *metav1.Time `json:"approvalTime,omitempty"`
+	// Progress is the latest progress reported by the backfill job
+	Progress *BackfillProgressApplyConfiguration `json:"progress,omitempty"`
 	// Conditions represent the latest available observations
-	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions []applyconfigurationsmetav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // BackfillRequestStatusApplyConfiguration constructs a declarative configuration of the BackfillRequestStatus type for use with
@@ -43,15 +57,63 @@ func BackfillRequestStatus() *BackfillRequestStatusApplyConfiguration {
 // WithPhase sets the Phase field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Phase field is set to the value of the last call.
-func (b *BackfillRequestStatusApplyConfiguration) WithPhase(value streamingv1.Phase) *BackfillRequestStatusApplyConfiguration {
+func (b *BackfillRequestStatusApplyConfiguration) WithPhase(value streamingv1.BackfillRequestPhase) *BackfillRequestStatusApplyConfiguration {
 	b.Phase = &value
 	return b
 }
 
+// WithFailedAttempts sets the FailedAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedAttempts field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithFailedAttempts(value int32) *BackfillRequestStatusApplyConfiguration {
+	b.FailedAttempts = &value
+	return b
+}
+
+// WithLastFailureTime sets the LastFailureTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailureTime field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithLastFailureTime(value metav1.Time) *BackfillRequestStatusApplyConfiguration {
+	b.LastFailureTime = &value
+	return b
+}
+
+// WithLastFailedJobUID sets the LastFailedJobUID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailedJobUID field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithLastFailedJobUID(value types.UID) *BackfillRequestStatusApplyConfiguration {
+	b.LastFailedJobUID = &value
+	return b
+}
+
+// WithApprovedBy sets the ApprovedBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApprovedBy field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithApprovedBy(value string) *BackfillRequestStatusApplyConfiguration {
+	b.ApprovedBy = &value
+	return b
+}
+
+// WithApprovalTime sets the ApprovalTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApprovalTime field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithApprovalTime(value metav1.Time) *BackfillRequestStatusApplyConfiguration {
+	b.ApprovalTime = &value
+	return b
+}
+
+// WithProgress sets the Progress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Progress field is set to the value of the last call.
+func (b *BackfillRequestStatusApplyConfiguration) WithProgress(value *BackfillProgressApplyConfiguration) *BackfillRequestStatusApplyConfiguration {
+	b.Progress = value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
-func (b *BackfillRequestStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *BackfillRequestStatusApplyConfiguration {
+func (b *BackfillRequestStatusApplyConfiguration) WithConditions(values ...*applyconfigurationsmetav1.ConditionApplyConfiguration) *BackfillRequestStatusApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithConditions")
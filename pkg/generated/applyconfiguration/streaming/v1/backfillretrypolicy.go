@@ -0,0 +1,66 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackfillRetryPolicyApplyConfiguration represents a declarative configuration of the BackfillRetryPolicy type for use
+// with apply.
+//
+// BackfillRetryPolicy defines how failed backfill jobs are retried
+type BackfillRetryPolicyApplyConfiguration struct {
+	// MaxAttempts is the maximum number of backfill job runs, including the first one
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// InitialBackoff is the delay before the first retry, doubled for every following retry
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the upper limit of the delay between retries
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// BackfillRetryPolicyApplyConfiguration constructs a declarative configuration of the BackfillRetryPolicy type for use with
+// apply.
+func BackfillRetryPolicy() *BackfillRetryPolicyApplyConfiguration {
+	return &BackfillRetryPolicyApplyConfiguration{}
+}
+
+// WithMaxAttempts sets the MaxAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAttempts field is set to the value of the last call.
+func (b *BackfillRetryPolicyApplyConfiguration) WithMaxAttempts(value int32) *BackfillRetryPolicyApplyConfiguration {
+	b.MaxAttempts = &value
+	return b
+}
+
+// WithInitialBackoff sets the InitialBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitialBackoff field is set to the value of the last call.
+func (b *BackfillRetryPolicyApplyConfiguration) WithInitialBackoff(value metav1.Duration) *BackfillRetryPolicyApplyConfiguration {
+	b.InitialBackoff = &value
+	return b
+}
+
+// WithMaxBackoff sets the MaxBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxBackoff field is set to the value of the last call.
+func (b *BackfillRetryPolicyApplyConfiguration) WithMaxBackoff(value metav1.Duration) *BackfillRetryPolicyApplyConfiguration {
+	b.MaxBackoff = &value
+	return b
+}
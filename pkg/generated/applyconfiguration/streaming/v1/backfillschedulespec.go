@@ -0,0 +1,97 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// BackfillScheduleSpecApplyConfiguration represents a declarative configuration of the BackfillScheduleSpec type for use
+// with apply.
+//
+// BackfillScheduleSpec defines the desired state of a backfill schedule
+type BackfillScheduleSpecApplyConfiguration struct {
+	// Schedule is the cron expression defining when backfills are requested
+	Schedule *string `json:"schedule,omitempty"`
+	// StreamClass is the name of the stream class to backfill
+	StreamClass *string `json:"streamClass,omitempty"`
+	// StreamId is the ID of the stream to backfill
+	StreamId *string `json:"streamId,omitempty"`
+	// Selector selects the streams to backfill in the namespace of the schedule by their labels
+	Selector *metav1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// Suspend stops the schedule from requesting new backfills
+	Suspend *bool `json:"suspend,omitempty"`
+	// Parameters is copied to the backfill requests created by the schedule
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// BackfillScheduleSpecApplyConfiguration constructs a declarative configuration of the BackfillScheduleSpec type for use with
+// apply.
+func BackfillScheduleSpec() *BackfillScheduleSpecApplyConfiguration {
+	return &BackfillScheduleSpecApplyConfiguration{}
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithSchedule(value string) *BackfillScheduleSpecApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithStreamClass sets the StreamClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamClass field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithStreamClass(value string) *BackfillScheduleSpecApplyConfiguration {
+	b.StreamClass = &value
+	return b
+}
+
+// WithStreamId sets the StreamId field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamId field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithStreamId(value string) *BackfillScheduleSpecApplyConfiguration {
+	b.StreamId = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithSelector(value *metav1.LabelSelectorApplyConfiguration) *BackfillScheduleSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithSuspend(value bool) *BackfillScheduleSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithParameters sets the Parameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parameters field is set to the value of the last call.
+func (b *BackfillScheduleSpecApplyConfiguration) WithParameters(value runtime.RawExtension) *BackfillScheduleSpecApplyConfiguration {
+	b.Parameters = &value
+	return b
+}
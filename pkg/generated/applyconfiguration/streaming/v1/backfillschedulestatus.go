@@ -0,0 +1,72 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// BackfillScheduleStatusApplyConfiguration represents a declarative configuration of the BackfillScheduleStatus type for use
+// with apply.
+//
+// BackfillScheduleStatus defines the observed state of a backfill schedule
+type BackfillScheduleStatusApplyConfiguration struct {
+	// LastScheduleTime is the time of the last scheduled run, whether it requested backfills or was skipped
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// NextScheduleTime is the time of the next scheduled run
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// Conditions represent the latest available observations
+	Conditions []applyconfigurationsmetav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// BackfillScheduleStatusApplyConfiguration constructs a declarative configuration of the BackfillScheduleStatus type for use with
+// apply.
+func BackfillScheduleStatus() *BackfillScheduleStatusApplyConfiguration {
+	return &BackfillScheduleStatusApplyConfiguration{}
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *BackfillScheduleStatusApplyConfiguration) WithLastScheduleTime(value metav1.Time) *BackfillScheduleStatusApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
+
+// WithNextScheduleTime sets the NextScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextScheduleTime field is set to the value of the last call.
+func (b *BackfillScheduleStatusApplyConfiguration) WithNextScheduleTime(value metav1.Time) *BackfillScheduleStatusApplyConfiguration {
+	b.NextScheduleTime = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *BackfillScheduleStatusApplyConfiguration) WithConditions(values ...*applyconfigurationsmetav1.ConditionApplyConfiguration) *BackfillScheduleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodPolicyApplyConfiguration represents a declarative configuration of the PodPolicy type for use
+// with apply.
+//
+// PodPolicy defines the scheduling and metadata settings enforced on the jobs of a stream class.
+// Tolerations and image pull secrets are added to the ones of the job template. Node selector entries, labels and
+// annotations replace the entries of the job template with the same key. The priority class and the service account
+// replace the ones of the job template when set. Labels and annotations managed by the operator cannot be overridden.
+type PodPolicyApplyConfiguration struct {
+	// Tolerations are added to the pods of the jobs
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector entries are added to the node selector of the pods of the jobs
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// PriorityClassName is the priority class of the pods of the jobs
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// ServiceAccountName is the service account of the pods of the jobs
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+	// ImagePullSecrets are added to the pods of the jobs
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Labels are added to the jobs and their pods
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the jobs and their pods
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PodPolicyApplyConfiguration constructs a declarative configuration of the PodPolicy type for use with
+// apply.
+func PodPolicy() *PodPolicyApplyConfiguration {
+	return &PodPolicyApplyConfiguration{}
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *PodPolicyApplyConfiguration) WithTolerations(values ...corev1.Toleration) *PodPolicyApplyConfiguration {
+	for i := range values {
+		b.Tolerations = append(b.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *PodPolicyApplyConfiguration) WithNodeSelector(entries map[string]string) *PodPolicyApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *PodPolicyApplyConfiguration) WithPriorityClassName(value string) *PodPolicyApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *PodPolicyApplyConfiguration) WithServiceAccountName(value string) *PodPolicyApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *PodPolicyApplyConfiguration) WithImagePullSecrets(values ...corev1.LocalObjectReference) *PodPolicyApplyConfiguration {
+	for i := range values {
+		b.ImagePullSecrets = append(b.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *PodPolicyApplyConfiguration) WithLabels(entries map[string]string) *PodPolicyApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *PodPolicyApplyConfiguration) WithAnnotations(entries map[string]string) *PodPolicyApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
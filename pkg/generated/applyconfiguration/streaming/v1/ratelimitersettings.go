@@ -0,0 +1,78 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RateLimiterSettingsApplyConfiguration represents a declarative configuration of the RateLimiterSettings type for use
+// with apply.
+//
+// RateLimiterSettings defines the rate limiter of a stream controller. A reconciliation is delayed by the larger of
+// the per-stream exponential backoff and the delay of the overall token bucket.
+type RateLimiterSettingsApplyConfiguration struct {
+	// BaseDelay is the delay before retrying the reconciliation of a stream after the first failure, doubled for
+	// every following failure
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+	// MaxDelay is the upper limit of the delay before retrying the reconciliation of a stream
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+	// QPS is the overall number of reconciliations per second allowed by the token bucket
+	QPS *int32 `json:"qps,omitempty"`
+	// Burst is the size of the token bucket
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// RateLimiterSettingsApplyConfiguration constructs a declarative configuration of the RateLimiterSettings type for use with
+// apply.
+func RateLimiterSettings() *RateLimiterSettingsApplyConfiguration {
+	return &RateLimiterSettingsApplyConfiguration{}
+}
+
+// WithBaseDelay sets the BaseDelay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseDelay field is set to the value of the last call.
+func (b *RateLimiterSettingsApplyConfiguration) WithBaseDelay(value metav1.Duration) *RateLimiterSettingsApplyConfiguration {
+	b.BaseDelay = &value
+	return b
+}
+
+// WithMaxDelay sets the MaxDelay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDelay field is set to the value of the last call.
+func (b *RateLimiterSettingsApplyConfiguration) WithMaxDelay(value metav1.Duration) *RateLimiterSettingsApplyConfiguration {
+	b.MaxDelay = &value
+	return b
+}
+
+// WithQPS sets the QPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QPS field is set to the value of the last call.
+func (b *RateLimiterSettingsApplyConfiguration) WithQPS(value int32) *RateLimiterSettingsApplyConfiguration {
+	b.QPS = &value
+	return b
+}
+
+// WithBurst sets the Burst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Burst field is set to the value of the last call.
+func (b *RateLimiterSettingsApplyConfiguration) WithBurst(value int32) *RateLimiterSettingsApplyConfiguration {
+	b.Burst = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// SecretKeyReferenceApplyConfiguration represents a declarative configuration of the SecretKeyReference type for use
+// with apply.
+//
+// SecretKeyReference selects a key of a secret or config map
+type SecretKeyReferenceApplyConfiguration struct {
+	// Key is the key of the object
+	Key *string `json:"key,omitempty"`
+	// EnvName is the name of the environment variable holding the value of the key, the key itself if not set
+	EnvName *string `json:"envName,omitempty"`
+}
+
+// SecretKeyReferenceApplyConfiguration constructs a declarative configuration of the SecretKeyReference type for use with
+// apply.
+func SecretKeyReference() *SecretKeyReferenceApplyConfiguration {
+	return &SecretKeyReferenceApplyConfiguration{}
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *SecretKeyReferenceApplyConfiguration) WithKey(value string) *SecretKeyReferenceApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithEnvName sets the EnvName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnvName field is set to the value of the last call.
+func (b *SecretKeyReferenceApplyConfiguration) WithEnvName(value string) *SecretKeyReferenceApplyConfiguration {
+	b.EnvName = &value
+	return b
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+)
+
+// SecretReferenceApplyConfiguration represents a declarative configuration of the SecretReference type for use
+// with apply.
+//
+// SecretReference defines how the secret or config map referenced by a field of the stream spec is injected into
+// the jobs of the stream. Without keys and mount path, all keys of the object are injected as environment variables.
+type SecretReferenceApplyConfiguration struct {
+	// FieldName is the name of the field of the stream spec referencing the object by name
+	FieldName *string `json:"fieldName,omitempty"`
+	// Kind is the kind of the referenced object
+	Kind *streamingv1.ReferencedObjectKind `json:"kind,omitempty"`
+	// Keys selects the keys of the object to inject. Without mount path, each key is injected as an environment
+	// variable, otherwise only the selected keys are mounted as files.
+	Keys []SecretKeyReferenceApplyConfiguration `json:"keys,omitempty"`
+	// MountPath mounts the object as a read-only volume at the given path instead of environment variables
+	MountPath *string `json:"mountPath,omitempty"`
+	// Containers limits the injection to the containers with the given names, all containers if not set
+	Containers []string `json:"containers,omitempty"`
+}
+
+// SecretReferenceApplyConfiguration constructs a declarative configuration of the SecretReference type for use with
+// apply.
+func SecretReference() *SecretReferenceApplyConfiguration {
+	return &SecretReferenceApplyConfiguration{}
+}
+
+// WithFieldName sets the FieldName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FieldName field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithFieldName(value string) *SecretReferenceApplyConfiguration {
+	b.FieldName = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithKind(value streamingv1.ReferencedObjectKind) *SecretReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithKeys adds the given value to the Keys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Keys field.
+func (b *SecretReferenceApplyConfiguration) WithKeys(values ...*SecretKeyReferenceApplyConfiguration) *SecretReferenceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithKeys")
+		}
+		b.Keys = append(b.Keys, *values[i])
+	}
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *SecretReferenceApplyConfiguration) WithMountPath(value string) *SecretReferenceApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *SecretReferenceApplyConfiguration) WithContainers(values ...string) *SecretReferenceApplyConfiguration {
+	for i := range values {
+		b.Containers = append(b.Containers, values[i])
+	}
+	return b
+}
@@ -18,6 +18,12 @@ limitations under the License.
 
 package v1
 
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // StreamClassSpecApplyConfiguration represents a declarative configuration of the StreamClassSpec type for use
 // with apply.
 //
@@ -33,6 +39,34 @@ type StreamClassSpecApplyConfiguration struct {
 	PluralName *string `json:"pluralName,omitempty"`
 	// SecretRefs is a list of fields to be extracted from the secret
 	SecretRefs []string `json:"secretRefs,omitempty"`
+	// SecretReferences is a list of fields of the stream spec referencing secrets or config maps, with the way they
+	// are injected into the jobs. Unlike SecretRefs, individual keys can be selected, the object can be mounted as a
+	// volume and the injection can be limited to some containers.
+	SecretReferences []SecretReferenceApplyConfiguration `json:"secretReferences,omitempty"`
+	// IgnoreSecretRotation disables restarting the jobs of the streams of this class when the data of the secrets
+	// referenced by the streams changes
+	IgnoreSecretRotation *bool `json:"ignoreSecretRotation,omitempty"`
+	// BackfillRetryPolicy is the default retry policy for the backfills of streams of this class
+	BackfillRetryPolicy *BackfillRetryPolicyApplyConfiguration `json:"backfillRetryPolicy,omitempty"`
+	// BackfillApprovalRequired indicates whether backfills of streams of this class must be approved before they start
+	BackfillApprovalRequired *bool `json:"backfillApprovalRequired,omitempty"`
+	// MaxBackfillDuration is the default maximum duration of a backfill job run for streams of this class
+	MaxBackfillDuration *metav1.Duration `json:"maxBackfillDuration,omitempty"`
+	// NamespaceSelector selects the namespaces of the streams managed by this class, all namespaces if not set
+	NamespaceSelector *applyconfigurationsmetav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	// StreamSelector selects the streams managed by this class by their labels, all streams if not set
+	StreamSelector *applyconfigurationsmetav1.LabelSelectorApplyConfiguration `json:"streamSelector,omitempty"`
+	// Defaults are applied to the streams of this class that leave the corresponding settings empty
+	Defaults *StreamDefaultsApplyConfiguration `json:"defaults,omitempty"`
+	// PodPolicy is enforced on all jobs of the streams of this class on top of their job templates
+	PodPolicy *PodPolicyApplyConfiguration `json:"podPolicy,omitempty"`
+	// Suspend suspends all streams of this class without changing the spec of the streams. When the class is resumed,
+	// each stream returns to the state defined by its own spec.
+	Suspend *bool `json:"suspend,omitempty"`
+	// Controller tunes the reconciliation of the streams of this class, unset fields use the operator-wide defaults
+	Controller *StreamControllerSettingsApplyConfiguration `json:"controller,omitempty"`
+	// DeletionPolicy defines what happens to the streams of this class and their jobs when the class is deleted
+	DeletionPolicy *streamingv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // StreamClassSpecApplyConfiguration constructs a declarative configuration of the StreamClassSpec type for use with
@@ -82,3 +116,104 @@ func (b *StreamClassSpecApplyConfiguration) WithSecretRefs(values ...string) *St
 	}
 	return b
 }
+
+// WithSecretReferences adds the given value to the SecretReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SecretReferences field.
+func (b *StreamClassSpecApplyConfiguration) WithSecretReferences(values ...*SecretReferenceApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSecretReferences")
+		}
+		b.SecretReferences = append(b.SecretReferences, *values[i])
+	}
+	return b
+}
+
+// WithIgnoreSecretRotation sets the IgnoreSecretRotation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IgnoreSecretRotation field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithIgnoreSecretRotation(value bool) *StreamClassSpecApplyConfiguration {
+	b.IgnoreSecretRotation = &value
+	return b
+}
+
+// WithBackfillRetryPolicy sets the BackfillRetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackfillRetryPolicy field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithBackfillRetryPolicy(value *BackfillRetryPolicyApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.BackfillRetryPolicy = value
+	return b
+}
+
+// WithBackfillApprovalRequired sets the BackfillApprovalRequired field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackfillApprovalRequired field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithBackfillApprovalRequired(value bool) *StreamClassSpecApplyConfiguration {
+	b.BackfillApprovalRequired = &value
+	return b
+}
+
+// WithMaxBackfillDuration sets the MaxBackfillDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxBackfillDuration field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithMaxBackfillDuration(value metav1.Duration) *StreamClassSpecApplyConfiguration {
+	b.MaxBackfillDuration = &value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithNamespaceSelector(value *applyconfigurationsmetav1.LabelSelectorApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithStreamSelector sets the StreamSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamSelector field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithStreamSelector(value *applyconfigurationsmetav1.LabelSelectorApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.StreamSelector = value
+	return b
+}
+
+// WithDefaults sets the Defaults field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Defaults field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithDefaults(value *StreamDefaultsApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.Defaults = value
+	return b
+}
+
+// WithPodPolicy sets the PodPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodPolicy field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithPodPolicy(value *PodPolicyApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.PodPolicy = value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithSuspend(value bool) *StreamClassSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithController sets the Controller field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Controller field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithController(value *StreamControllerSettingsApplyConfiguration) *StreamClassSpecApplyConfiguration {
+	b.Controller = value
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *StreamClassSpecApplyConfiguration) WithDeletionPolicy(value streamingv1.DeletionPolicy) *StreamClassSpecApplyConfiguration {
+	b.DeletionPolicy = &value
+	return b
+}
@@ -20,7 +20,8 @@ package v1
 
 import (
 	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
-	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // StreamClassStatusApplyConfiguration represents a declarative configuration of the StreamClassStatus type for use
@@ -30,8 +31,22 @@ import (
 type StreamClassStatusApplyConfiguration struct {
 	// Phase represents the current phase of the stream class
 	Phase *streamingv1.Phase `json:"phase,omitempty"`
+	// ObservedGeneration is the generation of the stream class observed by the last status update
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// ControllerStartTime is the time the stream controller for this class was started
+	ControllerStartTime *metav1.Time `json:"controllerStartTime,omitempty"`
+	// ControllerRestarts is the number of times the stream controller for this class was restarted after a failure
+	ControllerRestarts *int32 `json:"controllerRestarts,omitempty"`
+	// LastControllerFailureTime is the time the stream controller for this class last exited with an error
+	LastControllerFailureTime *metav1.Time `json:"lastControllerFailureTime,omitempty"`
+	// Streams is the number of streams of this class per stream phase
+	Streams map[string]int32 `json:"streams,omitempty"`
+	// TotalStreams is the number of streams of this class
+	TotalStreams *int32 `json:"totalStreams,omitempty"`
+	// ActiveBackfills is the number of backfill requests for streams of this class that have not been completed
+	ActiveBackfills *int32 `json:"activeBackfills,omitempty"`
 	// Conditions represent the latest available observations
-	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions []applyconfigurationsmetav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // StreamClassStatusApplyConfiguration constructs a declarative configuration of the StreamClassStatus type for use with
@@ -48,10 +63,72 @@ func (b *StreamClassStatusApplyConfiguration) WithPhase(value streamingv1.Phase)
 	return b
 }
 
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithObservedGeneration(value int64) *StreamClassStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithControllerStartTime sets the ControllerStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControllerStartTime field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithControllerStartTime(value metav1.Time) *StreamClassStatusApplyConfiguration {
+	b.ControllerStartTime = &value
+	return b
+}
+
+// WithControllerRestarts sets the ControllerRestarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControllerRestarts field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithControllerRestarts(value int32) *StreamClassStatusApplyConfiguration {
+	b.ControllerRestarts = &value
+	return b
+}
+
+// WithLastControllerFailureTime sets the LastControllerFailureTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastControllerFailureTime field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithLastControllerFailureTime(value metav1.Time) *StreamClassStatusApplyConfiguration {
+	b.LastControllerFailureTime = &value
+	return b
+}
+
+// WithStreams puts the entries into the Streams field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Streams field,
+// overwriting an existing map entries in Streams field with the same key.
+func (b *StreamClassStatusApplyConfiguration) WithStreams(entries map[string]int32) *StreamClassStatusApplyConfiguration {
+	if b.Streams == nil && len(entries) > 0 {
+		b.Streams = make(map[string]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.Streams[k] = v
+	}
+	return b
+}
+
+// WithTotalStreams sets the TotalStreams field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalStreams field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithTotalStreams(value int32) *StreamClassStatusApplyConfiguration {
+	b.TotalStreams = &value
+	return b
+}
+
+// WithActiveBackfills sets the ActiveBackfills field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveBackfills field is set to the value of the last call.
+func (b *StreamClassStatusApplyConfiguration) WithActiveBackfills(value int32) *StreamClassStatusApplyConfiguration {
+	b.ActiveBackfills = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
-func (b *StreamClassStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *StreamClassStatusApplyConfiguration {
+func (b *StreamClassStatusApplyConfiguration) WithConditions(values ...*applyconfigurationsmetav1.ConditionApplyConfiguration) *StreamClassStatusApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithConditions")
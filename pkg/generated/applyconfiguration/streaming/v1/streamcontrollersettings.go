@@ -0,0 +1,66 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StreamControllerSettingsApplyConfiguration represents a declarative configuration of the StreamControllerSettings type for use
+// with apply.
+//
+// StreamControllerSettings defines how the streams of a stream class are reconciled
+type StreamControllerSettingsApplyConfiguration struct {
+	// MaxConcurrentReconciles is the maximum number of streams of the class reconciled concurrently
+	MaxConcurrentReconciles *int32 `json:"maxConcurrentReconciles,omitempty"`
+	// RateLimiter limits how often the streams of the class are reconciled
+	RateLimiter *RateLimiterSettingsApplyConfiguration `json:"rateLimiter,omitempty"`
+	// ReconcileTimeout is the maximum duration of the reconciliation of a single stream
+	ReconcileTimeout *metav1.Duration `json:"reconcileTimeout,omitempty"`
+}
+
+// StreamControllerSettingsApplyConfiguration constructs a declarative configuration of the StreamControllerSettings type for use with
+// apply.
+func StreamControllerSettings() *StreamControllerSettingsApplyConfiguration {
+	return &StreamControllerSettingsApplyConfiguration{}
+}
+
+// WithMaxConcurrentReconciles sets the MaxConcurrentReconciles field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConcurrentReconciles field is set to the value of the last call.
+func (b *StreamControllerSettingsApplyConfiguration) WithMaxConcurrentReconciles(value int32) *StreamControllerSettingsApplyConfiguration {
+	b.MaxConcurrentReconciles = &value
+	return b
+}
+
+// WithRateLimiter sets the RateLimiter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimiter field is set to the value of the last call.
+func (b *StreamControllerSettingsApplyConfiguration) WithRateLimiter(value *RateLimiterSettingsApplyConfiguration) *StreamControllerSettingsApplyConfiguration {
+	b.RateLimiter = value
+	return b
+}
+
+// WithReconcileTimeout sets the ReconcileTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReconcileTimeout field is set to the value of the last call.
+func (b *StreamControllerSettingsApplyConfiguration) WithReconcileTimeout(value metav1.Duration) *StreamControllerSettingsApplyConfiguration {
+	b.ReconcileTimeout = &value
+	return b
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StreamDefaultsApplyConfiguration represents a declarative configuration of the StreamDefaults type for use
+// with apply.
+//
+// StreamDefaults defines the settings used by the streams of a stream class that do not set them
+type StreamDefaultsApplyConfiguration struct {
+	// StreamingJobTemplateRef is the StreamingJobTemplate used for the streaming jobs
+	StreamingJobTemplateRef *corev1.ObjectReference `json:"streamingJobTemplateRef,omitempty"`
+	// BackfillJobTemplateRef is the StreamingJobTemplate used for the backfill jobs
+	BackfillJobTemplateRef *corev1.ObjectReference `json:"backfillJobTemplateRef,omitempty"`
+	// Backend is the backend of the streams that do not define a streaming backend
+	Backend *streamingv1.StreamBackend `json:"backend,omitempty"`
+	// Schedule is the schedule of the streams running with the CronJob backend
+	Schedule *string `json:"schedule,omitempty"`
+}
+
+// StreamDefaultsApplyConfiguration constructs a declarative configuration of the StreamDefaults type for use with
+// apply.
+func StreamDefaults() *StreamDefaultsApplyConfiguration {
+	return &StreamDefaultsApplyConfiguration{}
+}
+
+// WithStreamingJobTemplateRef sets the StreamingJobTemplateRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamingJobTemplateRef field is set to the value of the last call.
+func (b *StreamDefaultsApplyConfiguration) WithStreamingJobTemplateRef(value corev1.ObjectReference) *StreamDefaultsApplyConfiguration {
+	b.StreamingJobTemplateRef = &value
+	return b
+}
+
+// WithBackfillJobTemplateRef sets the BackfillJobTemplateRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackfillJobTemplateRef field is set to the value of the last call.
+func (b *StreamDefaultsApplyConfiguration) WithBackfillJobTemplateRef(value corev1.ObjectReference) *StreamDefaultsApplyConfiguration {
+	b.BackfillJobTemplateRef = &value
+	return b
+}
+
+// WithBackend sets the Backend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backend field is set to the value of the last call.
+func (b *StreamDefaultsApplyConfiguration) WithBackend(value streamingv1.StreamBackend) *StreamDefaultsApplyConfiguration {
+	b.Backend = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *StreamDefaultsApplyConfiguration) WithSchedule(value string) *StreamDefaultsApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
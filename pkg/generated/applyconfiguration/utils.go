@@ -32,18 +32,46 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=streaming.sneaksanddata.com, Version=v1
+	case v1.SchemeGroupVersion.WithKind("BackfillCampaign"):
+		return &streamingv1.BackfillCampaignApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillCampaignSpec"):
+		return &streamingv1.BackfillCampaignSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillCampaignStatus"):
+		return &streamingv1.BackfillCampaignStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillProgress"):
+		return &streamingv1.BackfillProgressApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BackfillRequest"):
 		return &streamingv1.BackfillRequestApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BackfillRequestSpec"):
 		return &streamingv1.BackfillRequestSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BackfillRequestStatus"):
 		return &streamingv1.BackfillRequestStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillRetryPolicy"):
+		return &streamingv1.BackfillRetryPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillSchedule"):
+		return &streamingv1.BackfillScheduleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillScheduleSpec"):
+		return &streamingv1.BackfillScheduleSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BackfillScheduleStatus"):
+		return &streamingv1.BackfillScheduleStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PodPolicy"):
+		return &streamingv1.PodPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RateLimiterSettings"):
+		return &streamingv1.RateLimiterSettingsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecretKeyReference"):
+		return &streamingv1.SecretKeyReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecretReference"):
+		return &streamingv1.SecretReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StreamClass"):
 		return &streamingv1.StreamClassApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StreamClassSpec"):
 		return &streamingv1.StreamClassSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StreamClassStatus"):
 		return &streamingv1.StreamClassStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StreamControllerSettings"):
+		return &streamingv1.StreamControllerSettingsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("StreamDefaults"):
+		return &streamingv1.StreamDefaultsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StreamingJobTemplate"):
 		return &streamingv1.StreamingJobTemplateApplyConfiguration{}
 
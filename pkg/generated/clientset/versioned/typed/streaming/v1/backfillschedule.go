@@ -0,0 +1,74 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	applyconfigurationstreamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/applyconfiguration/streaming/v1"
+	scheme "github.com/SneaksAndData/arcane-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// BackfillSchedulesGetter has a method to return a BackfillScheduleInterface.
+// A group's client should implement this interface.
+type BackfillSchedulesGetter interface {
+	BackfillSchedules(namespace string) BackfillScheduleInterface
+}
+
+// BackfillScheduleInterface has methods to work with BackfillSchedule resources.
+type BackfillScheduleInterface interface {
+	Create(ctx context.Context, backfillSchedule *streamingv1.BackfillSchedule, opts metav1.CreateOptions) (*streamingv1.BackfillSchedule, error)
+	Update(ctx context.Context, backfillSchedule *streamingv1.BackfillSchedule, opts metav1.UpdateOptions) (*streamingv1.BackfillSchedule, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, backfillSchedule *streamingv1.BackfillSchedule, opts metav1.UpdateOptions) (*streamingv1.BackfillSchedule, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*streamingv1.BackfillSchedule, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*streamingv1.BackfillScheduleList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *streamingv1.BackfillSchedule, err error)
+	Apply(ctx context.Context, backfillSchedule *applyconfigurationstreamingv1.BackfillScheduleApplyConfiguration, opts metav1.ApplyOptions) (result *streamingv1.BackfillSchedule, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, backfillSchedule *applyconfigurationstreamingv1.BackfillScheduleApplyConfiguration, opts metav1.ApplyOptions) (result *streamingv1.BackfillSchedule, err error)
+	BackfillScheduleExpansion
+}
+
+// backfillSchedules implements BackfillScheduleInterface
+type backfillSchedules struct {
+	*gentype.ClientWithListAndApply[*streamingv1.BackfillSchedule, *streamingv1.BackfillScheduleList, *applyconfigurationstreamingv1.BackfillScheduleApplyConfiguration]
+}
+
+// newBackfillSchedules returns a BackfillSchedules
+func newBackfillSchedules(c *StreamingV1Client, namespace string) *backfillSchedules {
+	return &backfillSchedules{
+		gentype.NewClientWithListAndApply[*streamingv1.BackfillSchedule, *streamingv1.BackfillScheduleList, *applyconfigurationstreamingv1.BackfillScheduleApplyConfiguration](
+			"backfillschedules",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *streamingv1.BackfillSchedule { return &streamingv1.BackfillSchedule{} },
+			func() *streamingv1.BackfillScheduleList { return &streamingv1.BackfillScheduleList{} },
+		),
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/applyconfiguration/streaming/v1"
+	typedstreamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/clientset/versioned/typed/streaming/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeBackfillCampaigns implements BackfillCampaignInterface
+type fakeBackfillCampaigns struct {
+	*gentype.FakeClientWithListAndApply[*v1.BackfillCampaign, *v1.BackfillCampaignList, *streamingv1.BackfillCampaignApplyConfiguration]
+	Fake *FakeStreamingV1
+}
+
+func newFakeBackfillCampaigns(fake *FakeStreamingV1) typedstreamingv1.BackfillCampaignInterface {
+	return &fakeBackfillCampaigns{
+		gentype.NewFakeClientWithListAndApply[*v1.BackfillCampaign, *v1.BackfillCampaignList, *streamingv1.BackfillCampaignApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("backfillcampaigns"),
+			v1.SchemeGroupVersion.WithKind("BackfillCampaign"),
+			func() *v1.BackfillCampaign { return &v1.BackfillCampaign{} },
+			func() *v1.BackfillCampaignList { return &v1.BackfillCampaignList{} },
+			func(dst, src *v1.BackfillCampaignList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.BackfillCampaignList) []*v1.BackfillCampaign { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.BackfillCampaignList, items []*v1.BackfillCampaign) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
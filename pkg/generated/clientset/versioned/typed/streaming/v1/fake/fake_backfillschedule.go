@@ -0,0 +1,51 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/applyconfiguration/streaming/v1"
+	typedstreamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/clientset/versioned/typed/streaming/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeBackfillSchedules implements BackfillScheduleInterface
+type fakeBackfillSchedules struct {
+	*gentype.FakeClientWithListAndApply[*v1.BackfillSchedule, *v1.BackfillScheduleList, *streamingv1.BackfillScheduleApplyConfiguration]
+	Fake *FakeStreamingV1
+}
+
+func newFakeBackfillSchedules(fake *FakeStreamingV1, namespace string) typedstreamingv1.BackfillScheduleInterface {
+	return &fakeBackfillSchedules{
+		gentype.NewFakeClientWithListAndApply[*v1.BackfillSchedule, *v1.BackfillScheduleList, *streamingv1.BackfillScheduleApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("backfillschedules"),
+			v1.SchemeGroupVersion.WithKind("BackfillSchedule"),
+			func() *v1.BackfillSchedule { return &v1.BackfillSchedule{} },
+			func() *v1.BackfillScheduleList { return &v1.BackfillScheduleList{} },
+			func(dst, src *v1.BackfillScheduleList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.BackfillScheduleList) []*v1.BackfillSchedule { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.BackfillScheduleList, items []*v1.BackfillSchedule) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
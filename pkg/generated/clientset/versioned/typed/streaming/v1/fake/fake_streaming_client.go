@@ -28,10 +28,18 @@ type FakeStreamingV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeStreamingV1) BackfillCampaigns() v1.BackfillCampaignInterface {
+	return newFakeBackfillCampaigns(c)
+}
+
 func (c *FakeStreamingV1) BackfillRequests(namespace string) v1.BackfillRequestInterface {
 	return newFakeBackfillRequests(c, namespace)
 }
 
+func (c *FakeStreamingV1) BackfillSchedules(namespace string) v1.BackfillScheduleInterface {
+	return newFakeBackfillSchedules(c, namespace)
+}
+
 func (c *FakeStreamingV1) StreamClasses(namespace string) v1.StreamClassInterface {
 	return newFakeStreamClasses(c, namespace)
 }
@@ -18,8 +18,12 @@ limitations under the License.
 
 package v1
 
+type BackfillCampaignExpansion interface{}
+
 type BackfillRequestExpansion interface{}
 
+type BackfillScheduleExpansion interface{}
+
 type StreamClassExpansion interface{}
 
 type StreamingJobTemplateExpansion interface{}
@@ -28,7 +28,9 @@ import (
 
 type StreamingV1Interface interface {
 	RESTClient() rest.Interface
+	BackfillCampaignsGetter
 	BackfillRequestsGetter
+	BackfillSchedulesGetter
 	StreamClassesGetter
 	StreamingJobTemplatesGetter
 }
@@ -38,10 +40,18 @@ type StreamingV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *StreamingV1Client) BackfillCampaigns() BackfillCampaignInterface {
+	return newBackfillCampaigns(c)
+}
+
 func (c *StreamingV1Client) BackfillRequests(namespace string) BackfillRequestInterface {
 	return newBackfillRequests(c, namespace)
 }
 
+func (c *StreamingV1Client) BackfillSchedules(namespace string) BackfillScheduleInterface {
+	return newBackfillSchedules(c, namespace)
+}
+
 func (c *StreamingV1Client) StreamClasses(namespace string) StreamClassInterface {
 	return newStreamClasses(c, namespace)
 }
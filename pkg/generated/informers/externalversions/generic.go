@@ -53,8 +53,12 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=streaming.sneaksanddata.com, Version=v1
+	case v1.SchemeGroupVersion.WithResource("backfillcampaigns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Streaming().V1().BackfillCampaigns().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("backfillrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Streaming().V1().BackfillRequests().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("backfillschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Streaming().V1().BackfillSchedules().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("streamclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Streaming().V1().StreamClasses().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("streamingjobtemplates"):
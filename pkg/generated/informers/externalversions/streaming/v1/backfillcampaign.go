@@ -0,0 +1,101 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	apisstreamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	versioned "github.com/SneaksAndData/arcane-operator/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/SneaksAndData/arcane-operator/pkg/generated/informers/externalversions/internalinterfaces"
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/generated/listers/streaming/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BackfillCampaignInformer provides access to a shared informer and lister for
+// BackfillCampaigns.
+type BackfillCampaignInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() streamingv1.BackfillCampaignLister
+}
+
+type backfillCampaignInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewBackfillCampaignInformer constructs a new informer for BackfillCampaign type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBackfillCampaignInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBackfillCampaignInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBackfillCampaignInformer constructs a new informer for BackfillCampaign type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBackfillCampaignInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.StreamingV1().BackfillCampaigns().List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.StreamingV1().BackfillCampaigns().Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.StreamingV1().BackfillCampaigns().List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.StreamingV1().BackfillCampaigns().Watch(ctx, options)
+			},
+		}, client),
+		&apisstreamingv1.BackfillCampaign{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *backfillCampaignInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBackfillCampaignInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *backfillCampaignInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisstreamingv1.BackfillCampaign{}, f.defaultInformer)
+}
+
+func (f *backfillCampaignInformer) Lister() streamingv1.BackfillCampaignLister {
+	return streamingv1.NewBackfillCampaignLister(f.Informer().GetIndexer())
+}
@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BackfillCampaigns returns a BackfillCampaignInformer.
+	BackfillCampaigns() BackfillCampaignInformer
 	// BackfillRequests returns a BackfillRequestInformer.
 	BackfillRequests() BackfillRequestInformer
+	// BackfillSchedules returns a BackfillScheduleInformer.
+	BackfillSchedules() BackfillScheduleInformer
 	// StreamClasses returns a StreamClassInformer.
 	StreamClasses() StreamClassInformer
 	// StreamingJobTemplates returns a StreamingJobTemplateInformer.
@@ -43,11 +47,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BackfillCampaigns returns a BackfillCampaignInformer.
+func (v *version) BackfillCampaigns() BackfillCampaignInformer {
+	return &backfillCampaignInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // BackfillRequests returns a BackfillRequestInformer.
 func (v *version) BackfillRequests() BackfillRequestInformer {
 	return &backfillRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackfillSchedules returns a BackfillScheduleInformer.
+func (v *version) BackfillSchedules() BackfillScheduleInformer {
+	return &backfillScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // StreamClasses returns a StreamClassInformer.
 func (v *version) StreamClasses() StreamClassInformer {
 	return &streamClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
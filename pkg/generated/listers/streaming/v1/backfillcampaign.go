@@ -0,0 +1,48 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BackfillCampaignLister helps list BackfillCampaigns.
+// All objects returned here must be treated as read-only.
+type BackfillCampaignLister interface {
+	// List lists all BackfillCampaigns in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*streamingv1.BackfillCampaign, err error)
+	// Get retrieves the BackfillCampaign from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*streamingv1.BackfillCampaign, error)
+	BackfillCampaignListerExpansion
+}
+
+// backfillCampaignLister implements the BackfillCampaignLister interface.
+type backfillCampaignLister struct {
+	listers.ResourceIndexer[*streamingv1.BackfillCampaign]
+}
+
+// NewBackfillCampaignLister returns a new BackfillCampaignLister.
+func NewBackfillCampaignLister(indexer cache.Indexer) BackfillCampaignLister {
+	return &backfillCampaignLister{listers.New[*streamingv1.BackfillCampaign](indexer, streamingv1.Resource("backfillcampaign"))}
+}
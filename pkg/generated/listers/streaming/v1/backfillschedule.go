@@ -0,0 +1,70 @@
+/*
+Copyright 2024-2026 ECCO Data & AI Open-Source Project Maintainers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BackfillScheduleLister helps list BackfillSchedules.
+// All objects returned here must be treated as read-only.
+type BackfillScheduleLister interface {
+	// List lists all BackfillSchedules in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*streamingv1.BackfillSchedule, err error)
+	// BackfillSchedules returns an object that can list and get BackfillSchedules.
+	BackfillSchedules(namespace string) BackfillScheduleNamespaceLister
+	BackfillScheduleListerExpansion
+}
+
+// backfillScheduleLister implements the BackfillScheduleLister interface.
+type backfillScheduleLister struct {
+	listers.ResourceIndexer[*streamingv1.BackfillSchedule]
+}
+
+// NewBackfillScheduleLister returns a new BackfillScheduleLister.
+func NewBackfillScheduleLister(indexer cache.Indexer) BackfillScheduleLister {
+	return &backfillScheduleLister{listers.New[*streamingv1.BackfillSchedule](indexer, streamingv1.Resource("backfillschedule"))}
+}
+
+// BackfillSchedules returns an object that can list and get BackfillSchedules.
+func (s *backfillScheduleLister) BackfillSchedules(namespace string) BackfillScheduleNamespaceLister {
+	return backfillScheduleNamespaceLister{listers.NewNamespaced[*streamingv1.BackfillSchedule](s.ResourceIndexer, namespace)}
+}
+
+// BackfillScheduleNamespaceLister helps list and get BackfillSchedules.
+// All objects returned here must be treated as read-only.
+type BackfillScheduleNamespaceLister interface {
+	// List lists all BackfillSchedules in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*streamingv1.BackfillSchedule, err error)
+	// Get retrieves the BackfillSchedule from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*streamingv1.BackfillSchedule, error)
+	BackfillScheduleNamespaceListerExpansion
+}
+
+// backfillScheduleNamespaceLister implements the BackfillScheduleNamespaceLister
+// interface.
+type backfillScheduleNamespaceLister struct {
+	listers.ResourceIndexer[*streamingv1.BackfillSchedule]
+}
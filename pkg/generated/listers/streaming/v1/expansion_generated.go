@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1
 
+// BackfillCampaignListerExpansion allows custom methods to be added to
+// BackfillCampaignLister.
+type BackfillCampaignListerExpansion interface{}
+
 // BackfillRequestListerExpansion allows custom methods to be added to
 // BackfillRequestLister.
 type BackfillRequestListerExpansion interface{}
@@ -26,6 +30,14 @@ type BackfillRequestListerExpansion interface{}
 // BackfillRequestNamespaceLister.
 type BackfillRequestNamespaceListerExpansion interface{}
 
+// BackfillScheduleListerExpansion allows custom methods to be added to
+// BackfillScheduleLister.
+type BackfillScheduleListerExpansion interface{}
+
+// BackfillScheduleNamespaceListerExpansion allows custom methods to be added to
+// BackfillScheduleNamespaceLister.
+type BackfillScheduleNamespaceListerExpansion interface{}
+
 // StreamClassListerExpansion allows custom methods to be added to
 // StreamClassLister.
 type StreamClassListerExpansion interface{}
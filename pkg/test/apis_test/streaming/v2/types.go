@@ -74,6 +74,9 @@ type MockStreamDefinitionStatus struct {
 
 	// ConfigurationHash represents the hash of the current configuration.
 	ConfigurationHash string `json:"configurationHash"`
+
+	// Conditions represent the latest available observations of the stream state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // MockStreamDefinition is a mock implementation of the StreamDefinition for testing purposes.
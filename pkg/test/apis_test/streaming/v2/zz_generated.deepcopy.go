@@ -22,9 +22,33 @@ limitations under the License.
 package v2
 
 import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchJobBackend) DeepCopyInto(out *BatchJobBackend) {
+	*out = *in
+	out.JobTemplateRef = in.JobTemplateRef
+	if in.BackfillJobTemplateRef != nil {
+		in, out := &in.BackfillJobTemplateRef, &out.BackfillJobTemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchJobBackend.
+func (in *BatchJobBackend) DeepCopy() *BatchJobBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchJobBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronJobBackend) DeepCopyInto(out *CronJobBackend) {
 	*out = *in
@@ -45,7 +69,11 @@ func (in *CronJobBackend) DeepCopy() *CronJobBackend {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionSettings) DeepCopyInto(out *ExecutionSettings) {
 	*out = *in
-	out.BackfillJobTemplateRef = in.BackfillJobTemplateRef
+	if in.BackfillJobTemplateRef != nil {
+		in, out := &in.BackfillJobTemplateRef, &out.BackfillJobTemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
 	in.StreamingBackend.DeepCopyInto(&out.StreamingBackend)
 	return
 }
@@ -66,7 +94,7 @@ func (in *MockStreamDefinition) DeepCopyInto(out *MockStreamDefinition) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -142,6 +170,13 @@ func (in *MockStreamDefinitionSpec) DeepCopy() *MockStreamDefinitionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MockStreamDefinitionStatus) DeepCopyInto(out *MockStreamDefinitionStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -155,30 +190,13 @@ func (in *MockStreamDefinitionStatus) DeepCopy() *MockStreamDefinitionStatus {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BatchJobBackend) DeepCopyInto(out *BatchJobBackend) {
-	*out = *in
-	out.JobTemplateRef = in.JobTemplateRef
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchJobBackend.
-func (in *BatchJobBackend) DeepCopy() *BatchJobBackend {
-	if in == nil {
-		return nil
-	}
-	out := new(BatchJobBackend)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StreamingBackend) DeepCopyInto(out *StreamingBackend) {
 	*out = *in
 	if in.BatchJobBackend != nil {
 		in, out := &in.BatchJobBackend, &out.BatchJobBackend
 		*out = new(BatchJobBackend)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CronJobBackend != nil {
 		in, out := &in.CronJobBackend, &out.CronJobBackend
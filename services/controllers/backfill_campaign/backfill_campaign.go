@@ -0,0 +1,313 @@
+package backfill_campaign
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CampaignLabel is the label key used to link backfill requests to the campaign that created them.
+const CampaignLabel = "arcane/backfill-campaign"
+
+// StreamClassResolvedCondition is the condition type reporting whether the stream class of the campaign exists.
+const StreamClassResolvedCondition = "StreamClassResolved"
+
+// StreamsSelectedCondition is the condition type reporting whether the campaign selects any stream.
+const StreamsSelectedCondition = "StreamsSelected"
+
+// streamClassRetryInterval is the interval between attempts to resolve a missing stream class.
+const streamClassRetryInterval = time.Minute
+
+// streamSelectionRetryInterval is the interval between attempts to select streams for a campaign that selects none.
+const streamSelectionRetryInterval = time.Minute
+
+var _ reconcile.Reconciler = (*BackfillCampaignReconciler)(nil)
+
+// BackfillCampaignReconciler fans out a BackfillCampaign into BackfillRequests for every selected stream.
+type BackfillCampaignReconciler struct {
+	client        client.Client
+	eventRecorder record.EventRecorder
+}
+
+func NewBackfillCampaignReconciler(client client.Client, eventRecorder record.EventRecorder) *BackfillCampaignReconciler {
+	return &BackfillCampaignReconciler{
+		client:        client,
+		eventRecorder: eventRecorder,
+	}
+}
+
+func (r *BackfillCampaignReconciler) SetupWithManager(mgr runtime.Manager) error { // coverage-ignore (should be tested in e2e)
+	return runtime.NewControllerManagedBy(mgr).
+		For(&v1.BackfillCampaign{}).
+		Owns(&v1.BackfillRequest{}).
+		Complete(r)
+}
+
+func (r *BackfillCampaignReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx).WithValues("campaign", request.Name)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(0).Info("Reconciling BackfillCampaign")
+
+	campaign := &v1.BackfillCampaign{}
+	err := r.client.Get(ctx, request.NamespacedName, campaign)
+	if apierrors.IsNotFound(err) { // coverage-ignore
+		logger.V(0).Info("backfill campaign not found, might have been deleted")
+		return reconcile.Result{}, nil
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	if campaign.Status.Phase == v1.BackfillCampaignPhaseCompleted {
+		logger.V(1).Info("backfill campaign is already completed")
+		return reconcile.Result{}, nil
+	}
+
+	sc := &v1.StreamClass{}
+	err = r.client.Get(ctx, types.NamespacedName{Name: campaign.Spec.StreamClass}, sc)
+	if apierrors.IsNotFound(err) {
+		logger.V(0).Info("stream class of the backfill campaign not found", "streamClass", campaign.Spec.StreamClass)
+		status := campaign.Status.DeepCopy()
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    StreamClassResolvedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  "StreamClassNotFound",
+			Message: fmt.Sprintf("StreamClass %s does not exist", campaign.Spec.StreamClass),
+		})
+		return reconcile.Result{RequeueAfter: streamClassRetryInterval}, r.updateStatus(ctx, campaign, status, func() {
+			r.eventRecorder.Eventf(campaign, corev1.EventTypeWarning, "StreamClassNotFound",
+				"StreamClass %s does not exist", campaign.Spec.StreamClass)
+		})
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	streams, err := r.selectStreams(ctx, campaign, sc)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to list streams for the backfill campaign")
+		return reconcile.Result{}, err
+	}
+
+	requests := &v1.BackfillRequestList{}
+	err = r.client.List(ctx, requests, client.MatchingLabels{CampaignLabel: campaign.Name})
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to list backfill requests of the backfill campaign")
+		return reconcile.Result{}, err
+	}
+
+	children := make(map[types.NamespacedName]*v1.BackfillRequest, len(requests.Items))
+	for i := range requests.Items {
+		child := &requests.Items[i]
+		children[types.NamespacedName{Namespace: child.Namespace, Name: child.Spec.StreamId}] = child
+	}
+
+	// Only the requests of the selected streams count towards the concurrency limit: the request of a deleted stream
+	// is never started and would otherwise block the campaign forever.
+	active := int32(0)
+	for _, s := range streams {
+		child, ok := children[types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}]
+		if ok && !child.Spec.Completed {
+			active++
+		}
+	}
+
+	status := campaign.Status.DeepCopy()
+	status.Total, status.Pending, status.Running, status.Succeeded, status.Failed = int32(len(streams)), 0, 0, 0, 0
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    StreamClassResolvedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  "StreamClassFound",
+		Message: fmt.Sprintf("StreamClass %s exists", campaign.Spec.StreamClass),
+	})
+
+	if len(streams) == 0 {
+		logger.V(0).Info("backfill campaign does not select any stream")
+		status.Phase = v1.BackfillCampaignPhaseRunning
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    StreamsSelectedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  "NoStreamsSelected",
+			Message: fmt.Sprintf("No stream of StreamClass %s matches the campaign selector", campaign.Spec.StreamClass),
+		})
+		return reconcile.Result{RequeueAfter: streamSelectionRetryInterval}, r.updateStatus(ctx, campaign, status, func() {
+			r.eventRecorder.Eventf(campaign, corev1.EventTypeWarning, "NoStreamsSelected",
+				"No stream of StreamClass %s matches the campaign selector", campaign.Spec.StreamClass)
+		})
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    StreamsSelectedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  "StreamsSelected",
+		Message: fmt.Sprintf("%d streams are selected", len(streams)),
+	})
+
+	for _, s := range streams {
+		name := types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}
+		child, ok := children[name]
+		if ok {
+			countRequest(status, child)
+			continue
+		}
+
+		status.Pending++
+		if active >= maxConcurrency(campaign) {
+			continue
+		}
+
+		err = r.createRequest(ctx, campaign, name)
+		if apierrors.IsAlreadyExists(err) {
+			// A request that does not belong to the campaign already has the name, leave the stream pending.
+			logger.V(0).Info("backfill request name is already taken", "stream", name)
+			r.eventRecorder.Eventf(campaign, corev1.EventTypeWarning, "BackfillRequestNameConflict",
+				"Backfill request %s for stream %s already exists and does not belong to the campaign", requestName(campaign, name), name)
+			continue
+		}
+		if err != nil { // coverage-ignore
+			logger.V(0).Error(err, "unable to create backfill request", "stream", name)
+			return reconcile.Result{}, err
+		}
+		active++
+	}
+
+	var eventFunc func()
+	status.Phase = v1.BackfillCampaignPhaseRunning
+	if status.Succeeded+status.Failed == status.Total {
+		status.Phase = v1.BackfillCampaignPhaseCompleted
+		eventFunc = func() {
+			r.eventRecorder.Eventf(campaign, corev1.EventTypeNormal, "BackfillCampaignCompleted",
+				"Backfill campaign completed: %d succeeded, %d failed", status.Succeeded, status.Failed)
+		}
+	}
+
+	return reconcile.Result{}, r.updateStatus(ctx, campaign, status, eventFunc)
+}
+
+// selectStreams returns the streams of the stream class selected by the campaign, sorted by namespace and name.
+func (r *BackfillCampaignReconciler) selectStreams(ctx context.Context, campaign *v1.BackfillCampaign, sc *v1.StreamClass) ([]unstructured.Unstructured, error) {
+	selector := labels.Everything()
+	if campaign.Spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(campaign.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stream selector: %w", err)
+		}
+	}
+
+	var streams []unstructured.Unstructured
+	if len(campaign.Spec.Namespaces) == 0 {
+		items, err := stream.ListStreamsForClass(ctx, r.client, sc, client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+		streams = items
+	}
+
+	for _, namespace := range campaign.Spec.Namespaces {
+		items, err := stream.ListStreamsForClass(ctx, r.client, sc, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, items...)
+	}
+
+	slices.SortFunc(streams, func(a, b unstructured.Unstructured) int {
+		if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return streams, nil
+}
+
+func (r *BackfillCampaignReconciler) createRequest(ctx context.Context, campaign *v1.BackfillCampaign, name types.NamespacedName) error {
+	request := &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestName(campaign, name),
+			Namespace: name.Namespace,
+			Labels:    map[string]string{CampaignLabel: campaign.Name},
+		},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: campaign.Spec.StreamClass,
+			StreamId:    name.Name,
+			From:        campaign.Spec.From,
+			To:          campaign.Spec.To,
+			Parameters:  campaign.Spec.Parameters,
+		},
+	}
+
+	err := controllerutil.SetControllerReference(campaign, request, r.client.Scheme())
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	err = r.client.Create(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	r.eventRecorder.Eventf(campaign, corev1.EventTypeNormal, "BackfillRequested",
+		"Backfill requested for stream %s", name)
+	return nil
+}
+
+func (r *BackfillCampaignReconciler) updateStatus(ctx context.Context, campaign *v1.BackfillCampaign, status *v1.BackfillCampaignStatus, eventFunc func()) error {
+	if equality.Semantic.DeepEqual(campaign.Status, *status) {
+		return nil
+	}
+
+	campaign.Status = *status
+	err := r.client.Status().Update(ctx, campaign)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill campaign status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+	return nil
+}
+
+// requestName returns the name of the backfill request of the campaign for the stream.
+func requestName(campaign *v1.BackfillCampaign, name types.NamespacedName) string {
+	return stream.BackfillRequestName(campaign.Name, name.Name)
+}
+
+func countRequest(status *v1.BackfillCampaignStatus, request *v1.BackfillRequest) {
+	switch {
+	case request.Status.Phase == v1.BackfillRequestPhaseRunning:
+		status.Running++
+	case request.Status.Phase == v1.BackfillRequestPhaseFailed || request.Status.Phase == v1.BackfillRequestPhaseCancelled:
+		status.Failed++
+	case request.Status.Phase == v1.BackfillRequestPhaseSucceeded || request.Spec.Completed:
+		status.Succeeded++
+	default:
+		status.Pending++
+	}
+}
+
+func maxConcurrency(campaign *v1.BackfillCampaign) int32 {
+	if campaign.Spec.MaxConcurrency < 1 {
+		return 1
+	}
+	return campaign.Spec.MaxConcurrency
+}
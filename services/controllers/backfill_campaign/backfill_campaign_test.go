@@ -0,0 +1,313 @@
+package backfill_campaign
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var campaignName = types.NamespacedName{Name: "campaign1"}
+
+func Test_Reconcile_Creates_Requests_Up_To_MaxConcurrency(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 2, nil,
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns1", "stream-b", "orders"),
+		newStream("ns2", "stream-c", "orders"),
+		newStream("ns2", "stream-d", "customers"),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{}, result)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.Equal(t, "stream-a", requests[0].Spec.StreamId)
+	require.Equal(t, "stream-b", requests[1].Spec.StreamId)
+	require.Equal(t, "campaign1", requests[0].Labels[CampaignLabel])
+	require.Len(t, requests[0].OwnerReferences, 1)
+
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, v1.BackfillCampaignPhaseRunning, campaign.Status.Phase)
+	require.Equal(t, int32(3), campaign.Status.Total)
+	require.Equal(t, int32(3), campaign.Status.Pending)
+}
+
+func Test_Reconcile_Respects_Namespaces(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 5, []string{"ns2"},
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns2", "stream-c", "orders"),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 1)
+	require.Equal(t, "ns2", requests[0].Namespace)
+	require.Equal(t, "stream-c", requests[0].Spec.StreamId)
+}
+
+func Test_Reconcile_Waits_For_Active_Requests(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil,
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns1", "stream-b", "orders"),
+		newRequest("stream-a", v1.BackfillRequestPhaseRunning, false),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, listRequests(t, k8sClient), 1)
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, int32(1), campaign.Status.Running)
+	require.Equal(t, int32(1), campaign.Status.Pending)
+}
+
+func Test_Reconcile_Continues_When_Request_Completes(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil,
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns1", "stream-b", "orders"),
+		newRequest("stream-a", v1.BackfillRequestPhaseSucceeded, true),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.Equal(t, "stream-b", requests[1].Spec.StreamId)
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, int32(1), campaign.Status.Succeeded)
+	require.Equal(t, int32(1), campaign.Status.Pending)
+}
+
+func Test_Reconcile_Completes_Campaign(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil,
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns1", "stream-b", "orders"),
+		newRequest("stream-a", v1.BackfillRequestPhaseSucceeded, true),
+		newRequest("stream-b", v1.BackfillRequestPhaseCancelled, true),
+	)
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, recorder)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, v1.BackfillCampaignPhaseCompleted, campaign.Status.Phase)
+	require.Equal(t, int32(1), campaign.Status.Succeeded)
+	require.Equal(t, int32(1), campaign.Status.Failed)
+	require.Contains(t, <-recorder.Events, "BackfillCampaignCompleted")
+}
+
+func Test_Reconcile_StreamClass_Not_Found(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil)
+	require.NoError(t, k8sClient.Delete(t.Context(), &v1.StreamClass{ObjectMeta: metav1.ObjectMeta{Name: "stream-class"}}))
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, streamClassRetryInterval, result.RequeueAfter)
+	campaign := getCampaign(t, k8sClient)
+	require.True(t, meta.IsStatusConditionFalse(campaign.Status.Conditions, StreamClassResolvedCondition))
+}
+
+func Test_Reconcile_No_Streams_Selected(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil,
+		newStream("ns1", "stream-d", "customers"),
+	)
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, recorder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, streamSelectionRetryInterval, result.RequeueAfter)
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, v1.BackfillCampaignPhaseRunning, campaign.Status.Phase)
+	require.True(t, meta.IsStatusConditionFalse(campaign.Status.Conditions, StreamsSelectedCondition))
+	require.Contains(t, <-recorder.Events, "NoStreamsSelected")
+}
+
+func Test_Reconcile_Ignores_Requests_Of_Deleted_Streams(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, 1, nil,
+		newStream("ns1", "stream-b", "orders"),
+		newRequest("stream-a", v1.BackfillRequestPhaseNew, false),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.Equal(t, "stream-b", requests[1].Spec.StreamId)
+	campaign := getCampaign(t, k8sClient)
+	require.Equal(t, int32(1), campaign.Status.Total)
+	require.Equal(t, int32(1), campaign.Status.Pending)
+}
+
+func Test_Reconcile_Request_Names_Are_Unique_And_Bounded(t *testing.T) {
+	// Arrange
+	longName := "stream-" + strings.Repeat("x", 80)
+	k8sClient := setupFakeClient(t, 5, nil,
+		newStream("ns1", longName, "orders"),
+		newStream("ns1", longName+"y", "orders"),
+	)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.NotEqual(t, requests[0].Name, requests[1].Name)
+	for _, request := range requests {
+		require.LessOrEqual(t, len(request.Name), validation.DNS1123LabelMaxLength)
+		require.True(t, strings.HasPrefix(request.Name, "campaign1-stream-"))
+	}
+}
+
+func Test_Reconcile_Request_Name_Conflict(t *testing.T) {
+	// Arrange
+	campaign := &v1.BackfillCampaign{ObjectMeta: metav1.ObjectMeta{Name: campaignName.Name}}
+	conflicting := &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      requestName(campaign, types.NamespacedName{Namespace: "ns1", Name: "stream-a"}),
+		},
+		Spec: v1.BackfillRequestSpec{StreamClass: "stream-class", StreamId: "stream-a"},
+	}
+	k8sClient := setupFakeClient(t, 2, nil,
+		newStream("ns1", "stream-a", "orders"),
+		newStream("ns1", "stream-b", "orders"),
+		conflicting,
+	)
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillCampaignReconciler(k8sClient, recorder)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: campaignName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.Equal(t, "stream-b", requests[1].Spec.StreamId)
+	require.Contains(t, <-recorder.Events, "BackfillRequestNameConflict")
+	require.Equal(t, int32(2), getCampaign(t, k8sClient).Status.Pending)
+}
+
+func newStream(namespace, name, source string) client.Object {
+	return &testv1.MockStreamDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{"source": source},
+		},
+	}
+}
+
+func newRequest(streamId string, phase v1.BackfillRequestPhase, completed bool) client.Object {
+	return &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "campaign1-" + streamId,
+			Labels:    map[string]string{CampaignLabel: campaignName.Name},
+		},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: "stream-class",
+			StreamId:    streamId,
+			Completed:   completed,
+		},
+		Status: v1.BackfillRequestStatus{Phase: phase},
+	}
+}
+
+func setupFakeClient(t *testing.T, concurrency int32, namespaces []string, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, testv1.AddToScheme(scheme))
+
+	campaign := &v1.BackfillCampaign{
+		ObjectMeta: metav1.ObjectMeta{Name: campaignName.Name, UID: "campaign-uid"},
+		Spec: v1.BackfillCampaignSpec{
+			StreamClass:    "stream-class",
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"source": "orders"}},
+			Namespaces:     namespaces,
+			MaxConcurrency: concurrency,
+		},
+	}
+	sc := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "stream-class"},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		},
+	}
+
+	return crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1.BackfillCampaign{}, &v1.BackfillRequest{}).
+		WithObjects(append(objects, campaign, sc)...).
+		Build()
+}
+
+func getCampaign(t *testing.T, k8sClient client.Client) *v1.BackfillCampaign {
+	campaign := &v1.BackfillCampaign{}
+	require.NoError(t, k8sClient.Get(t.Context(), campaignName, campaign))
+	return campaign
+}
+
+func listRequests(t *testing.T, k8sClient client.Client) []v1.BackfillRequest {
+	requests := &v1.BackfillRequestList{}
+	require.NoError(t, k8sClient.List(t.Context(), requests))
+	return requests.Items
+}
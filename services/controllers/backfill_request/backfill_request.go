@@ -0,0 +1,206 @@
+package backfill_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// TargetResolvedCondition is the condition type reporting whether the stream class and the stream of the request exist.
+const TargetResolvedCondition = "TargetResolved"
+
+// recheckInterval is the interval between checks that the target of an active backfill request still exists.
+const recheckInterval = 5 * time.Minute
+
+// conflictRetryInterval is the interval before reconciling again a backfill request that was modified concurrently.
+const conflictRetryInterval = time.Second
+
+var _ reconcile.Reconciler = (*BackfillRequestReconciler)(nil)
+
+// BackfillRequestReconciler fails backfill requests that can never be picked up by a stream controller and links the
+// remaining ones to their streams. The requests are patched with optimistic locking, so a concurrent update by a stream
+// controller is never overwritten and the request is reconciled again instead.
+type BackfillRequestReconciler struct {
+	client        client.Client
+	eventRecorder record.EventRecorder
+}
+
+func NewBackfillRequestReconciler(client client.Client, eventRecorder record.EventRecorder) *BackfillRequestReconciler {
+	return &BackfillRequestReconciler{
+		client:        client,
+		eventRecorder: eventRecorder,
+	}
+}
+
+func (r *BackfillRequestReconciler) SetupWithManager(mgr runtime.Manager) error { // coverage-ignore (should be tested in e2e)
+	return runtime.NewControllerManagedBy(mgr).
+		For(&v1.BackfillRequest{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return !obj.(*v1.BackfillRequest).Spec.Completed
+		}))).
+		Complete(r)
+}
+
+func (r *BackfillRequestReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx).WithValues("backfillRequest", request.NamespacedName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(1).Info("Reconciling BackfillRequest")
+
+	result, err := r.reconcile(ctx, request)
+	if apierrors.IsConflict(err) {
+		logger.V(1).Info("backfill request was modified concurrently, retrying")
+		return reconcile.Result{RequeueAfter: conflictRetryInterval}, nil
+	}
+	return result, err
+}
+
+func (r *BackfillRequestReconciler) reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx)
+
+	bfr := &v1.BackfillRequest{}
+	err := r.client.Get(ctx, request.NamespacedName, bfr)
+	if apierrors.IsNotFound(err) { // coverage-ignore
+		logger.V(1).Info("backfill request not found, might have been deleted")
+		return reconcile.Result{}, nil
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	if bfr.Spec.Completed {
+		return reconcile.Result{}, nil
+	}
+
+	sc := &v1.StreamClass{}
+	err = r.client.Get(ctx, types.NamespacedName{Name: bfr.Spec.StreamClass}, sc)
+	if apierrors.IsNotFound(err) {
+		return reconcile.Result{}, r.fail(ctx, bfr, "StreamClassNotFound",
+			fmt.Sprintf("StreamClass %s does not exist", bfr.Spec.StreamClass))
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	if !sc.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, r.fail(ctx, bfr, "StreamClassDeleted",
+			fmt.Sprintf("StreamClass %s is being deleted", bfr.Spec.StreamClass))
+	}
+
+	// A stream class that is not ready is waiting for its custom resource definition or for the restart of its
+	// controller, the request is picked up once the controller is running.
+	if sc.Status.Phase != v1.PhaseReady {
+		logger.V(0).Info("waiting for the stream class controller to start", "streamClass", bfr.Spec.StreamClass)
+		message := fmt.Sprintf("The controller of StreamClass %s is not running (phase %q)", bfr.Spec.StreamClass, sc.Status.Phase)
+		return reconcile.Result{RequeueAfter: recheckInterval}, r.setCondition(ctx, bfr, metav1.ConditionFalse, "StreamClassNotReady", message)
+	}
+
+	target, err := stream.GetStreamResourceForClass(ctx, r.client, sc, types.NamespacedName{Namespace: bfr.Namespace, Name: bfr.Spec.StreamId})
+	if apierrors.IsNotFound(err) {
+		return reconcile.Result{}, r.fail(ctx, bfr, "StreamNotFound",
+			fmt.Sprintf("Stream %s/%s of kind %s does not exist", bfr.Namespace, bfr.Spec.StreamId, sc.Spec.KindRef))
+	}
+	if errors.Is(err, stream.ErrStreamOutOfScope) {
+		return reconcile.Result{}, r.fail(ctx, bfr, "StreamOutOfScope",
+			fmt.Sprintf("Stream %s/%s is not selected by StreamClass %s", bfr.Namespace, bfr.Spec.StreamId, bfr.Spec.StreamClass))
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	err = r.ensureOwnerReference(ctx, bfr, target)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to set the owner reference of the backfill request")
+		return reconcile.Result{}, err
+	}
+
+	message := fmt.Sprintf("Stream %s/%s of StreamClass %s exists", bfr.Namespace, bfr.Spec.StreamId, bfr.Spec.StreamClass)
+	return reconcile.Result{RequeueAfter: recheckInterval}, r.setCondition(ctx, bfr, metav1.ConditionTrue, "StreamFound", message)
+}
+
+// ensureOwnerReference makes the stream an owner of the backfill request, so the request is deleted with the stream.
+func (r *BackfillRequestReconciler) ensureOwnerReference(ctx context.Context, bfr *v1.BackfillRequest, target *unstructured.Unstructured) error {
+	hasOwner, err := controllerutil.HasOwnerReference(bfr.OwnerReferences, target, r.client.Scheme())
+	if err != nil { // coverage-ignore
+		return err
+	}
+	if hasOwner {
+		return nil
+	}
+
+	patch := mergeFrom(bfr)
+	err = controllerutil.SetOwnerReference(target, bfr, r.client.Scheme())
+	if err != nil { // coverage-ignore
+		return err
+	}
+	return r.client.Patch(ctx, bfr, patch)
+}
+
+// fail marks the backfill request as completed in the Failed phase with the given reason.
+func (r *BackfillRequestReconciler) fail(ctx context.Context, bfr *v1.BackfillRequest, reason string, message string) error {
+	klog.FromContext(ctx).V(0).Info("marking backfill request as failed", "reason", reason, "message", message)
+
+	patch := mergeFrom(bfr)
+	bfr.Spec.Completed = true
+	err := r.client.Patch(ctx, bfr, patch)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to mark backfill request as completed: %w", err)
+	}
+
+	patch = mergeFrom(bfr)
+	bfr.Status.Phase = v1.BackfillRequestPhaseFailed
+	meta.SetStatusCondition(&bfr.Status.Conditions, metav1.Condition{
+		Type:    TargetResolvedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+	err = r.client.Status().Patch(ctx, bfr, patch)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	r.eventRecorder.Eventf(bfr, corev1.EventTypeWarning, reason, "Backfill request failed: %s", message)
+	return nil
+}
+
+func (r *BackfillRequestReconciler) setCondition(ctx context.Context, bfr *v1.BackfillRequest, status metav1.ConditionStatus, reason string, message string) error {
+	patch := mergeFrom(bfr)
+	changed := meta.SetStatusCondition(&bfr.Status.Conditions, metav1.Condition{
+		Type:    TargetResolvedCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+	if !changed {
+		return nil
+	}
+
+	err := r.client.Status().Patch(ctx, bfr, patch)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+	return nil
+}
+
+// mergeFrom returns a merge patch of the backfill request that fails with a conflict if the request has been modified
+// since it was read, since a merge patch replaces the whole list of conditions.
+func mergeFrom(bfr *v1.BackfillRequest) client.Patch {
+	return client.MergeFromWithOptions(bfr.DeepCopy(), client.MergeFromWithOptimisticLock{})
+}
@@ -0,0 +1,243 @@
+package backfill_request
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var requestName = types.NamespacedName{Name: "backfill1", Namespace: "default"}
+
+var created = time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+
+func Test_Reconcile_Sets_Owner_Reference(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "stream-class", newStream("stream1"))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, recheckInterval, result.RequeueAfter)
+	bfr := getRequest(t, k8sClient)
+	require.False(t, bfr.Spec.Completed)
+	require.Len(t, bfr.OwnerReferences, 1)
+	require.Equal(t, "MockStreamDefinition", bfr.OwnerReferences[0].Kind)
+	require.Equal(t, "stream1", bfr.OwnerReferences[0].Name)
+	require.True(t, meta.IsStatusConditionTrue(bfr.Status.Conditions, TargetResolvedCondition))
+}
+
+func Test_Reconcile_Stream_Not_Found(t *testing.T) {
+	// Arrange
+	recorder := record.NewFakeRecorder(10)
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "stream-class")
+	reconciler := NewBackfillRequestReconciler(k8sClient, recorder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, reconcile.Result{}, result)
+	assertFailed(t, k8sClient, "StreamNotFound")
+	require.Contains(t, <-recorder.Events, "StreamNotFound")
+}
+
+func Test_Reconcile_StreamClass_Not_Found(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "unknown-class", newStream("stream1"))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	assertFailed(t, k8sClient, "StreamClassNotFound")
+}
+
+func Test_Reconcile_Waits_For_StreamClass_Controller(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhasePending, "stream-class", newStream("stream1"))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, recheckInterval, result.RequeueAfter)
+	assertWaiting(t, k8sClient, "StreamClassNotReady")
+}
+
+func Test_Reconcile_Waits_For_StreamClass_Controller_Restart(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhaseFailed, "stream-class", newStream("stream1"))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, recheckInterval, result.RequeueAfter)
+	assertWaiting(t, k8sClient, "StreamClassNotReady")
+}
+
+func Test_Reconcile_StreamClass_Deleted(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "stream-class", newStream("stream1"))
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: "stream-class"}, sc))
+	sc.Finalizers = []string{"arcane.sneaksanddata.com/test"}
+	require.NoError(t, k8sClient.Update(t.Context(), sc))
+	require.NoError(t, k8sClient.Delete(t.Context(), sc))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	assertFailed(t, k8sClient, "StreamClassDeleted")
+}
+
+func Test_Reconcile_Stream_Out_Of_Scope(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "stream-class", newStream("stream1"))
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: "stream-class"}, sc))
+	sc.Spec.StreamSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "orders"}}
+	require.NoError(t, k8sClient.Update(t.Context(), sc))
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, reconcile.Result{}, result)
+	assertFailed(t, k8sClient, "StreamOutOfScope")
+	require.Empty(t, getRequest(t, k8sClient).OwnerReferences)
+}
+
+func Test_Reconcile_Concurrent_Status_Update(t *testing.T) {
+	// Arrange: the stream controller fails the request while the reconciler links it to the stream
+	k8sClient := setupFakeClient(t, v1.PhaseReady, "stream-class", newStream("stream1"))
+	concurrentUpdate := true
+	k8sClient = interceptor.NewClient(k8sClient.(client.WithWatch), interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if concurrentUpdate {
+				concurrentUpdate = false
+				bfr := &v1.BackfillRequest{}
+				require.NoError(t, c.Get(ctx, requestName, bfr))
+				bfr.Status.Phase = v1.BackfillRequestPhaseFailed
+				meta.SetStatusCondition(&bfr.Status.Conditions, metav1.Condition{Type: "Failed", Status: metav1.ConditionTrue, Reason: "BackoffLimitExceeded"})
+				require.NoError(t, c.Status().Update(ctx, bfr))
+			}
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	})
+	reconciler := NewBackfillRequestReconciler(k8sClient, record.NewFakeRecorder(10))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert: the concurrent update is kept and the request is reconciled again
+	require.Equal(t, reconcile.Result{RequeueAfter: conflictRetryInterval}, result)
+	bfr := getRequest(t, k8sClient)
+	require.Equal(t, v1.BackfillRequestPhaseFailed, bfr.Status.Phase)
+	require.True(t, meta.IsStatusConditionTrue(bfr.Status.Conditions, "Failed"))
+	require.Nil(t, meta.FindStatusCondition(bfr.Status.Conditions, TargetResolvedCondition))
+
+	// Act
+	_, err = reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: requestName})
+	require.NoError(t, err)
+
+	// Assert
+	bfr = getRequest(t, k8sClient)
+	require.True(t, meta.IsStatusConditionTrue(bfr.Status.Conditions, "Failed"))
+	require.True(t, meta.IsStatusConditionTrue(bfr.Status.Conditions, TargetResolvedCondition))
+}
+
+func newStream(name string) client.Object {
+	return &testv1.MockStreamDefinition{
+		ObjectMeta: metav1.ObjectMeta{Namespace: requestName.Namespace, Name: name, UID: "stream-uid"},
+	}
+}
+
+func setupFakeClient(t *testing.T, classPhase v1.Phase, streamClass string, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, testv1.AddToScheme(scheme))
+
+	sc := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "stream-class"},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		},
+		Status: v1.StreamClassStatus{Phase: classPhase},
+	}
+	bfr := &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         requestName.Namespace,
+			Name:              requestName.Name,
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: streamClass,
+			StreamId:    "stream1",
+		},
+	}
+
+	return crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1.BackfillRequest{}).
+		WithObjects(append(objects, sc, bfr)...).
+		Build()
+}
+
+func getRequest(t *testing.T, k8sClient client.Client) *v1.BackfillRequest {
+	bfr := &v1.BackfillRequest{}
+	require.NoError(t, k8sClient.Get(t.Context(), requestName, bfr))
+	return bfr
+}
+
+func assertFailed(t *testing.T, k8sClient client.Client, reason string) {
+	bfr := getRequest(t, k8sClient)
+	require.True(t, bfr.Spec.Completed)
+	require.Equal(t, v1.BackfillRequestPhaseFailed, bfr.Status.Phase)
+	condition := meta.FindStatusCondition(bfr.Status.Conditions, TargetResolvedCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, reason, condition.Reason)
+}
+
+func assertWaiting(t *testing.T, k8sClient client.Client, reason string) {
+	bfr := getRequest(t, k8sClient)
+	require.False(t, bfr.Spec.Completed)
+	require.NotEqual(t, v1.BackfillRequestPhaseFailed, bfr.Status.Phase)
+	condition := meta.FindStatusCondition(bfr.Status.Conditions, TargetResolvedCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, reason, condition.Reason)
+}
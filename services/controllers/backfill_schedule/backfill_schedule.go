@@ -0,0 +1,250 @@
+package backfill_schedule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ScheduleLabel is the label key used to link backfill requests to the schedule that created them.
+const ScheduleLabel = "arcane/backfill-schedule"
+
+// ScheduleValidCondition is the condition type reporting whether the cron expression of the schedule can be parsed.
+const ScheduleValidCondition = "ScheduleValid"
+
+// maxMissedRuns limits the number of missed runs inspected when the schedule was not evaluated for a long time.
+const maxMissedRuns = 1000
+
+var _ reconcile.Reconciler = (*BackfillScheduleReconciler)(nil)
+
+// BackfillScheduleReconciler creates BackfillRequests for the streams selected by a BackfillSchedule on its schedule.
+type BackfillScheduleReconciler struct {
+	client        client.Client
+	eventRecorder record.EventRecorder
+	clock         clock.PassiveClock
+}
+
+func NewBackfillScheduleReconciler(client client.Client, eventRecorder record.EventRecorder, clock clock.PassiveClock) *BackfillScheduleReconciler {
+	return &BackfillScheduleReconciler{
+		client:        client,
+		eventRecorder: eventRecorder,
+		clock:         clock,
+	}
+}
+
+func (r *BackfillScheduleReconciler) SetupWithManager(mgr runtime.Manager) error { // coverage-ignore (should be tested in e2e)
+	return runtime.NewControllerManagedBy(mgr).
+		For(&v1.BackfillSchedule{}).
+		Owns(&v1.BackfillRequest{}).
+		Complete(r)
+}
+
+func (r *BackfillScheduleReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx).WithValues("schedule", request.NamespacedName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(0).Info("Reconciling BackfillSchedule")
+
+	schedule := &v1.BackfillSchedule{}
+	err := r.client.Get(ctx, request.NamespacedName, schedule)
+	if apierrors.IsNotFound(err) { // coverage-ignore
+		logger.V(0).Info("backfill schedule not found, might have been deleted")
+		return reconcile.Result{}, nil
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	status := schedule.Status.DeepCopy()
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    ScheduleValidCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidSchedule",
+			Message: err.Error(),
+		})
+		status.NextScheduleTime = nil
+		return reconcile.Result{}, r.updateStatus(ctx, schedule, status, func() {
+			r.eventRecorder.Eventf(schedule, corev1.EventTypeWarning, "InvalidSchedule",
+				"Unable to parse schedule %q: %v", schedule.Spec.Schedule, err)
+		})
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    ScheduleValidCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ScheduleParsed",
+		Message: fmt.Sprintf("Schedule %q is valid", schedule.Spec.Schedule),
+	})
+
+	now := r.clock.Now()
+	next := cronSchedule.Next(now)
+	status.NextScheduleTime = &metav1.Time{Time: next}
+	result := reconcile.Result{RequeueAfter: next.Sub(now)}
+
+	if schedule.Spec.Suspend {
+		logger.V(1).Info("backfill schedule is suspended")
+		return result, r.updateStatus(ctx, schedule, status, nil)
+	}
+
+	scheduledTime, due := mostRecentRun(cronSchedule, schedule, now)
+	if !due {
+		return result, r.updateStatus(ctx, schedule, status, nil)
+	}
+	status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+
+	active, err := r.getActiveRequest(ctx, schedule)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to list backfill requests of the backfill schedule")
+		return reconcile.Result{}, err
+	}
+
+	if active != nil {
+		logger.V(0).Info("previous backfill is still active, skipping the run", "backfillRequest", active.Name)
+		return result, r.updateStatus(ctx, schedule, status, func() {
+			r.eventRecorder.Eventf(schedule, corev1.EventTypeNormal, "BackfillSkipped",
+				"Skipped the run scheduled at %s, backfill request %s is still active", scheduledTime.Format(time.RFC3339), active.Name)
+		})
+	}
+
+	streamIds, err := r.selectStreams(ctx, schedule)
+	if err != nil {
+		logger.V(0).Error(err, "unable to select streams for the backfill schedule")
+		return reconcile.Result{}, err
+	}
+
+	// A stream that cannot be backfilled, for example because it already has an active backfill request, is skipped
+	// for this run so that it does not block the other streams and the run is not retried forever.
+	failures := make(map[string]error)
+	for _, streamId := range streamIds {
+		err = r.createRequest(ctx, schedule, streamId, scheduledTime)
+		if err != nil {
+			logger.V(0).Error(err, "unable to create backfill request, skipping the stream", "streamId", streamId)
+			failures[streamId] = err
+		}
+	}
+
+	return result, r.updateStatus(ctx, schedule, status, func() {
+		for _, streamId := range streamIds {
+			if err, ok := failures[streamId]; ok {
+				r.eventRecorder.Eventf(schedule, corev1.EventTypeWarning, "BackfillRequestFailed",
+					"Unable to request backfill for stream %s for the run scheduled at %s: %v", streamId, scheduledTime.Format(time.RFC3339), err)
+			}
+		}
+		r.eventRecorder.Eventf(schedule, corev1.EventTypeNormal, "BackfillScheduled",
+			"Requested backfill for %d of %d stream(s) for the run scheduled at %s", len(streamIds)-len(failures), len(streamIds), scheduledTime.Format(time.RFC3339))
+	})
+}
+
+// mostRecentRun returns the most recent scheduled time that is not later than now and has not been handled yet.
+func mostRecentRun(cronSchedule cron.Schedule, schedule *v1.BackfillSchedule, now time.Time) (time.Time, bool) {
+	start := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		start = schedule.Status.LastScheduleTime.Time
+	}
+
+	var last time.Time
+	for t, i := cronSchedule.Next(start), 0; !t.After(now) && i < maxMissedRuns; t, i = cronSchedule.Next(t), i+1 {
+		last = t
+	}
+	return last, !last.IsZero()
+}
+
+func (r *BackfillScheduleReconciler) getActiveRequest(ctx context.Context, schedule *v1.BackfillSchedule) (*v1.BackfillRequest, error) {
+	requests := &v1.BackfillRequestList{}
+	err := r.client.List(ctx, requests, client.InNamespace(schedule.Namespace), client.MatchingLabels{ScheduleLabel: schedule.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range requests.Items {
+		if !requests.Items[i].Spec.Completed {
+			return &requests.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *BackfillScheduleReconciler) selectStreams(ctx context.Context, schedule *v1.BackfillSchedule) ([]string, error) {
+	if schedule.Spec.Selector == nil {
+		return []string{schedule.Spec.StreamId}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(schedule.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stream selector: %w", err)
+	}
+
+	sc := &v1.StreamClass{}
+	err = r.client.Get(ctx, types.NamespacedName{Name: schedule.Spec.StreamClass}, sc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream class %s: %w", schedule.Spec.StreamClass, err)
+	}
+
+	streams, err := stream.ListStreamsForClass(ctx, r.client, sc, client.InNamespace(schedule.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	streamIds := make([]string, 0, len(streams))
+	for _, s := range streams {
+		streamIds = append(streamIds, s.GetName())
+	}
+	return streamIds, nil
+}
+
+func (r *BackfillScheduleReconciler) createRequest(ctx context.Context, schedule *v1.BackfillSchedule, streamId string, scheduledTime time.Time) error {
+	request := &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      stream.BackfillRequestName(schedule.Name, streamId, strconv.FormatInt(scheduledTime.Unix(), 10)),
+			Namespace: schedule.Namespace,
+			Labels:    map[string]string{ScheduleLabel: schedule.Name},
+		},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: schedule.Spec.StreamClass,
+			StreamId:    streamId,
+			Parameters:  schedule.Spec.Parameters,
+		},
+	}
+
+	err := controllerutil.SetControllerReference(schedule, request, r.client.Scheme())
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	return client.IgnoreAlreadyExists(r.client.Create(ctx, request))
+}
+
+func (r *BackfillScheduleReconciler) updateStatus(ctx context.Context, schedule *v1.BackfillSchedule, status *v1.BackfillScheduleStatus, eventFunc func()) error {
+	if equality.Semantic.DeepEqual(schedule.Status, *status) {
+		return nil
+	}
+
+	schedule.Status = *status
+	err := r.client.Status().Update(ctx, schedule)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill schedule status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package backfill_schedule
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var scheduleName = types.NamespacedName{Name: "weekly", Namespace: "default"}
+
+// created is the creation time of the test schedule, a Monday
+var created = time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+
+func Test_Reconcile_Not_Due(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) { spec.StreamId = "stream1" })
+	reconciler := NewBackfillScheduleReconciler(k8sClient, record.NewFakeRecorder(10), clocktesting.NewFakePassiveClock(created.Add(time.Hour)))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Empty(t, listRequests(t, k8sClient))
+	schedule := getSchedule(t, k8sClient)
+	require.Nil(t, schedule.Status.LastScheduleTime)
+	require.Equal(t, time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), schedule.Status.NextScheduleTime.UTC())
+	require.Equal(t, schedule.Status.NextScheduleTime.Sub(created.Add(time.Hour)), result.RequeueAfter)
+}
+
+func Test_Reconcile_Creates_Request_For_Stream(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 11, 0, 5, 0, 0, time.UTC)
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) { spec.StreamId = "stream1" })
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillScheduleReconciler(k8sClient, recorder, clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 1)
+	require.Equal(t, "stream1", requests[0].Spec.StreamId)
+	require.Equal(t, "weekly", requests[0].Labels[ScheduleLabel])
+	require.Len(t, requests[0].OwnerReferences, 1)
+
+	schedule := getSchedule(t, k8sClient)
+	require.Equal(t, time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), schedule.Status.LastScheduleTime.UTC())
+	require.Equal(t, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC), schedule.Status.NextScheduleTime.UTC())
+	require.Contains(t, <-recorder.Events, "BackfillScheduled")
+}
+
+func Test_Reconcile_Request_Name_Is_Bounded(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 11, 0, 5, 0, 0, time.UTC)
+	longName := "stream-" + strings.Repeat("x", 80)
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) { spec.StreamId = longName })
+	reconciler := NewBackfillScheduleReconciler(k8sClient, record.NewFakeRecorder(10), clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 1)
+	require.Equal(t, longName, requests[0].Spec.StreamId)
+	require.LessOrEqual(t, len(requests[0].Name), validation.DNS1123LabelMaxLength)
+	require.True(t, strings.HasPrefix(requests[0].Name, "weekly-stream-"))
+}
+
+func Test_Reconcile_Creates_Requests_For_Selector(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 11, 0, 5, 0, 0, time.UTC)
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) {
+		spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"source": "orders"}}
+	},
+		newStream("default", "stream-a", "orders"),
+		newStream("default", "stream-b", "customers"),
+		newStream("other", "stream-c", "orders"),
+	)
+	reconciler := NewBackfillScheduleReconciler(k8sClient, record.NewFakeRecorder(10), clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 1)
+	require.Equal(t, "stream-a", requests[0].Spec.StreamId)
+}
+
+func Test_Reconcile_Skips_Run_When_Previous_Is_Active(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 18, 0, 5, 0, 0, time.UTC)
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) { spec.StreamId = "stream1" },
+		&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: scheduleName.Namespace,
+				Name:      "weekly-stream1-previous",
+				Labels:    map[string]string{ScheduleLabel: scheduleName.Name},
+			},
+			Spec: v1.BackfillRequestSpec{StreamClass: "stream-class", StreamId: "stream1"},
+		},
+	)
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillScheduleReconciler(k8sClient, recorder, clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, listRequests(t, k8sClient), 1)
+	schedule := getSchedule(t, k8sClient)
+	require.Equal(t, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC), schedule.Status.LastScheduleTime.UTC())
+	require.Contains(t, <-recorder.Events, "BackfillSkipped")
+}
+
+func Test_Reconcile_Suspended(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 11, 0, 5, 0, 0, time.UTC)
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) {
+		spec.StreamId = "stream1"
+		spec.Suspend = true
+	})
+	reconciler := NewBackfillScheduleReconciler(k8sClient, record.NewFakeRecorder(10), clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Empty(t, listRequests(t, k8sClient))
+	require.Nil(t, getSchedule(t, k8sClient).Status.LastScheduleTime)
+}
+
+func Test_Reconcile_Invalid_Schedule(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) {
+		spec.StreamId = "stream1"
+		spec.Schedule = "every sunday"
+	})
+	reconciler := NewBackfillScheduleReconciler(k8sClient, record.NewFakeRecorder(10), clocktesting.NewFakePassiveClock(created))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, reconcile.Result{}, result)
+	schedule := getSchedule(t, k8sClient)
+	require.True(t, meta.IsStatusConditionFalse(schedule.Status.Conditions, ScheduleValidCondition))
+}
+
+func Test_Reconcile_Skips_Stream_With_Active_Request(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 11, 0, 5, 0, 0, time.UTC)
+	fakeClient := setupFakeClient(t, func(spec *v1.BackfillScheduleSpec) {
+		spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"source": "orders"}}
+	},
+		newStream("default", "stream-a", "orders"),
+		newStream("default", "stream-b", "orders"),
+		&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: "default"},
+			Spec:       v1.BackfillRequestSpec{StreamClass: "stream-class", StreamId: "stream-a"},
+		},
+	)
+	// Emulates the admission webhook rejecting a second active request for the same stream
+	k8sClient := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if request, ok := obj.(*v1.BackfillRequest); ok && request.Spec.StreamId == "stream-a" {
+				return apierrors.NewForbidden(v1.Resource("backfillrequests"), request.Name,
+					errors.New("backfill request manual for stream default/stream-a is already active"))
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	})
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewBackfillScheduleReconciler(k8sClient, recorder, clocktesting.NewFakePassiveClock(now))
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: scheduleName})
+	require.NoError(t, err)
+
+	// Assert
+	requests := listRequests(t, k8sClient)
+	require.Len(t, requests, 2)
+	require.Equal(t, "stream-b", requests[1].Spec.StreamId)
+
+	schedule := getSchedule(t, k8sClient)
+	require.Equal(t, time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), schedule.Status.LastScheduleTime.UTC())
+	require.Contains(t, <-recorder.Events, "BackfillRequestFailed")
+	require.Contains(t, <-recorder.Events, "Requested backfill for 1 of 2 stream(s)")
+}
+
+func newStream(namespace, name, source string) client.Object {
+	return &testv1.MockStreamDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{"source": source},
+		},
+	}
+}
+
+func setupFakeClient(t *testing.T, updateSpec func(spec *v1.BackfillScheduleSpec), objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, testv1.AddToScheme(scheme))
+
+	schedule := &v1.BackfillSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              scheduleName.Name,
+			Namespace:         scheduleName.Namespace,
+			UID:               "schedule-uid",
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Spec: v1.BackfillScheduleSpec{
+			Schedule:    "CRON_TZ=UTC 0 0 * * 0",
+			StreamClass: "stream-class",
+		},
+	}
+	updateSpec(&schedule.Spec)
+
+	sc := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "stream-class"},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		},
+	}
+
+	return crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1.BackfillSchedule{}, &v1.BackfillRequest{}).
+		WithObjects(append(objects, schedule, sc)...).
+		Build()
+}
+
+func getSchedule(t *testing.T, k8sClient client.Client) *v1.BackfillSchedule {
+	schedule := &v1.BackfillSchedule{}
+	require.NoError(t, k8sClient.Get(t.Context(), scheduleName, schedule))
+	return schedule
+}
+
+func listRequests(t *testing.T, k8sClient client.Client) []v1.BackfillRequest {
+	requests := &v1.BackfillRequestList{}
+	require.NoError(t, k8sClient.List(t.Context(), requests))
+	return requests.Items
+}
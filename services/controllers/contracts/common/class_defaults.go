@@ -0,0 +1,63 @@
+package common
+
+import (
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ClassDefaults applies the defaults of a stream class to the settings left empty in a stream definition and keeps
+// track of whether any default has been used.
+type ClassDefaults struct {
+	defaults *v1.StreamDefaults
+	applied  bool
+}
+
+// NewClassDefaults creates a new ClassDefaults instance, the defaults can be nil.
+func NewClassDefaults(defaults *v1.StreamDefaults) *ClassDefaults {
+	if defaults == nil {
+		defaults = &v1.StreamDefaults{}
+	}
+	return &ClassDefaults{
+		defaults: defaults,
+	}
+}
+
+// StreamingJobTemplateRef returns ref if it names a template, the default streaming job template otherwise.
+func (c *ClassDefaults) StreamingJobTemplateRef(ref *corev1.ObjectReference) *corev1.ObjectReference {
+	return c.templateRef(ref, c.defaults.StreamingJobTemplateRef)
+}
+
+// BackfillJobTemplateRef returns ref if it names a template, the default backfill job template otherwise.
+func (c *ClassDefaults) BackfillJobTemplateRef(ref *corev1.ObjectReference) *corev1.ObjectReference {
+	return c.templateRef(ref, c.defaults.BackfillJobTemplateRef)
+}
+
+// Schedule returns the schedule if it is set, the default schedule otherwise.
+func (c *ClassDefaults) Schedule(schedule string) string {
+	if schedule != "" || c.defaults.Schedule == "" {
+		return schedule
+	}
+	c.applied = true
+	return c.defaults.Schedule
+}
+
+// Backend returns the default backend for a stream definition that does not define a streaming backend.
+func (c *ClassDefaults) Backend() v1.StreamBackend {
+	if c.defaults.Backend != "" {
+		c.applied = true
+	}
+	return c.defaults.Backend
+}
+
+// Applied returns true if any default has been used.
+func (c *ClassDefaults) Applied() bool {
+	return c.applied
+}
+
+func (c *ClassDefaults) templateRef(ref *corev1.ObjectReference, defaultRef *corev1.ObjectReference) *corev1.ObjectReference {
+	if (ref != nil && ref.Name != "") || defaultRef == nil {
+		return ref
+	}
+	c.applied = true
+	return defaultRef.DeepCopy()
+}
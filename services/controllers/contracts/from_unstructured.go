@@ -3,6 +3,7 @@ package contracts
 import (
 	"fmt"
 
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts/v0"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts/v2"
@@ -11,8 +12,9 @@ import (
 )
 
 // FromUnstructured converts an unstructured Kubernetes object into a stream.Definition, which is a structured
-// representation of the stream definition.
-func FromUnstructured(obj *unstructured.Unstructured) (stream.Definition, error) {
+// representation of the stream definition. The defaults of the stream class are applied to the settings left empty
+// in the object, the defaults can be nil.
+func FromUnstructured(obj *unstructured.Unstructured, defaults *streamingv1.StreamDefaults) (stream.Definition, error) {
 
 	layoutVersion, found, err := unstructured.NestedString(obj.Object, "spec", "execution", "layoutVersion")
 	if err != nil { // coverage-ignore
@@ -23,11 +25,11 @@ func FromUnstructured(obj *unstructured.Unstructured) (stream.Definition, error)
 
 	switch {
 	case !found || layoutVersion == "":
-		v = v0.NewUnstructuredWrapper(obj)
+		v = v0.NewUnstructuredWrapperWithDefaults(obj, defaults)
 	case layoutVersion == "v1":
-		v = v1.NewExecutionSettings(obj)
+		v = v1.NewExecutionSettingsWithDefaults(obj, defaults)
 	case layoutVersion == "v2":
-		v = v2.NewExecutionSettings(obj)
+		v = v2.NewExecutionSettingsWithDefaults(obj, defaults)
 	default:
 		return nil, fmt.Errorf("unknown layoutVersion: %s", layoutVersion)
 	}
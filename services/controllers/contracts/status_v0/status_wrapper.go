@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
@@ -17,6 +18,16 @@ type StatusWrapper struct {
 	phase         stream.Phase
 	underlying    *unstructured.Unstructured
 	configuration string
+	classDefaults *stream.EffectiveSettings
+	secretsHash   string
+	maxBackfill   *metav1.Duration
+}
+
+// backfillConfiguration is the part of a backfill request included in the configuration hash of the backfill job.
+type backfillConfiguration struct {
+	Override         v1.BackfillRequestSpec `json:"override"`
+	Partitions       int32                  `json:"partitions,omitempty"`
+	ClassMaxDuration *metav1.Duration       `json:"classMaxDuration,omitempty"`
 }
 
 func NewStatusWrapper(u *unstructured.Unstructured) *StatusWrapper {
@@ -34,6 +45,28 @@ func (s *StatusWrapper) SetPhase(phase stream.Phase) error {
 	return setNestedPhase(s.underlying, phase, "status", "phase")
 }
 
+// SetClassDefaults includes the effective settings of a stream using the defaults of its stream class in the
+// configuration, so the stream is updated when the defaults of the class change.
+func (s *StatusWrapper) SetClassDefaults(settings stream.EffectiveSettings) {
+	s.classDefaults = &settings
+}
+
+// SetReferencedSecretsHash sets the hash of the data of the secrets referenced by the stream, so the stream is updated
+// when the secrets rotate. The hash is not part of the configuration.
+func (s *StatusWrapper) SetReferencedSecretsHash(hash string) {
+	s.secretsHash = hash
+}
+
+func (s *StatusWrapper) ReferencedSecretsHash() string {
+	return s.secretsHash
+}
+
+// SetClassMaxBackfillDuration includes the maximum duration of the backfill jobs set by the stream class in the
+// configuration of the backfill requests.
+func (s *StatusWrapper) SetClassMaxBackfillDuration(duration *metav1.Duration) {
+	s.maxBackfill = duration
+}
+
 func (s *StatusWrapper) RecomputeConfiguration(request *v1.BackfillRequest) error {
 	currentConfig, err := s.CurrentConfiguration(request)
 	if err != nil { // coverage-ignore
@@ -61,6 +94,14 @@ func (s *StatusWrapper) CurrentConfiguration(request *v1.BackfillRequest) (strin
 		return "", err
 	}
 
+	if s.classDefaults != nil {
+		defaults, err := json.Marshal(s.classDefaults)
+		if err != nil { // coverage-ignore
+			return "", err
+		}
+		b = append(b, defaults...)
+	}
+
 	sum := md5.Sum(b)
 	selfConfiguration := hex.EncodeToString(sum[:])
 
@@ -68,8 +109,14 @@ func (s *StatusWrapper) CurrentConfiguration(request *v1.BackfillRequest) (strin
 		return selfConfiguration, nil
 	}
 
-	// Include backfill request spec in the configuration hash
-	bRequest, err := json.Marshal(request.Spec)
+	// Include the backfill request settings shaping the backfill job in the configuration hash, the settings used only
+	// by the operator, such as the maximum duration of the request, are left out so changing them does not restart
+	// the backfill
+	bRequest, err := json.Marshal(backfillConfiguration{
+		Override:         request.Spec.JobOverride(),
+		Partitions:       request.Spec.Partitions,
+		ClassMaxDuration: s.maxBackfill,
+	})
 	if err != nil { // coverage-ignore
 		return "", err
 	}
@@ -118,7 +165,7 @@ func (s *StatusWrapper) ComputeConditions(bfr *v1.BackfillRequest) []metav1.Cond
 				Type:    "Ready",
 				Status:  metav1.ConditionTrue,
 				Reason:  "StreamBackfilling",
-				Message: "The stream is currently backfilling data, request ID: " + bfr.Name,
+				Message: backfillingMessage(bfr),
 				LastTransitionTime: metav1.Time{
 					Time: metav1.Now().Time,
 				},
@@ -177,6 +224,24 @@ func (s *StatusWrapper) ComputeConditions(bfr *v1.BackfillRequest) []metav1.Cond
 	}
 }
 
+// backfillingMessage describes the running backfill, including the latest progress reported by the backfill job.
+func backfillingMessage(bfr *v1.BackfillRequest) string { // coverage-ignore
+	message := "The stream is currently backfilling data, request ID: " + bfr.Name
+	progress := bfr.Status.Progress
+	if progress == nil {
+		return message
+	}
+
+	message += fmt.Sprintf(", progress: %d%%, rows: %d", progress.Percent, progress.Rows)
+	if progress.Watermark != "" {
+		message += ", watermark: " + progress.Watermark
+	}
+	if progress.UpdateTime != nil {
+		message += ", updated: " + progress.UpdateTime.UTC().Format(time.RFC3339)
+	}
+	return message
+}
+
 func (s *StatusWrapper) ExtractConfigurationHash() error {
 	currentConfiguration, found, err := getNestedString(s.underlying, "status", "configurationHash")
 	if err != nil { // coverage-ignore
@@ -32,12 +32,20 @@ type UnstructuredWrapper struct {
 	suspended       bool
 	streamingJobRef corev1.ObjectReference
 	backfillJobRef  corev1.ObjectReference
+	defaults        *v1.StreamDefaults
 }
 
 // NewUnstructuredWrapper creates a new UnstructuredWrapper from the given unstructured object.
 func NewUnstructuredWrapper(obj *unstructured.Unstructured) stream.Definition {
+	return NewUnstructuredWrapperWithDefaults(obj, nil)
+}
+
+// NewUnstructuredWrapperWithDefaults creates a new UnstructuredWrapper from the given unstructured object, applying
+// the default job templates of the stream class to the job template references missing in the object.
+func NewUnstructuredWrapperWithDefaults(obj *unstructured.Unstructured, defaults *v1.StreamDefaults) stream.Definition {
 	w := &UnstructuredWrapper{
 		Underlying: obj,
+		defaults:   defaults,
 	}
 
 	w.StatusWrapper = status_v0.NewStatusWrapper(obj)
@@ -109,16 +117,20 @@ func (u *UnstructuredWrapper) Validate() error {
 		return err
 	}
 
-	err = u.extractStreamingJobRef("jobTemplateRef", &u.streamingJobRef)
+	defaults := common.NewClassDefaults(u.defaults)
+	err = u.extractStreamingJobRef("jobTemplateRef", defaults.StreamingJobTemplateRef, &u.streamingJobRef)
 	if err != nil { // coverage-ignore
 		return err
 	}
 
-	err = u.extractStreamingJobRef("backfillJobTemplateRef", &u.backfillJobRef)
+	err = u.extractStreamingJobRef("backfillJobTemplateRef", defaults.BackfillJobTemplateRef, &u.backfillJobRef)
 	if err != nil { // coverage-ignore
 		return err
 	}
 
+	if defaults.Applied() {
+		u.SetClassDefaults(u.GetEffectiveSettings())
+	}
 	return nil
 }
 
@@ -135,14 +147,27 @@ func (u *UnstructuredWrapper) GetSchedule() (string, error) {
 	return "", fmt.Errorf("schedule is not applicable for BatchJob backend")
 }
 
-func (u *UnstructuredWrapper) extractStreamingJobRef(from string, target *corev1.ObjectReference) error {
+func (u *UnstructuredWrapper) GetEffectiveSettings() stream.EffectiveSettings {
+	return stream.EffectiveSettings{
+		Backend:                u.GetBackend(),
+		JobTemplateRef:         &u.streamingJobRef,
+		BackfillJobTemplateRef: &u.backfillJobRef,
+	}
+}
+
+func (u *UnstructuredWrapper) extractStreamingJobRef(from string, withDefault func(*corev1.ObjectReference) *corev1.ObjectReference, target *corev1.ObjectReference) error {
 	uRef, found, err := unstructured.NestedFieldCopy(u.Underlying.Object, "spec", from)
 	if err != nil { // coverage-ignore
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("spec/jobTemplateRef field not found in object")
+		ref := withDefault(nil)
+		if ref == nil {
+			return fmt.Errorf("spec/jobTemplateRef field not found in object")
+		}
+		*target = *ref
+		return nil
 	}
 
 	m, ok := uRef.(map[string]interface{})
@@ -155,7 +180,7 @@ func (u *UnstructuredWrapper) extractStreamingJobRef(from string, target *corev1
 		return fmt.Errorf("failed to convert streamingJobRef to ObjectReference: %w", err)
 	}
 
-	*target = ref
+	*target = *withDefault(&ref)
 	return nil
 }
 
@@ -339,3 +339,27 @@ func getUnstructured(t *testing.T, fakeClient client.WithWatch) (unstructured.Un
 	require.NoError(t, err)
 	return unstructuredObj, err
 }
+
+func Test_ClassDefaults_MissingBackfillJobTemplate(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(nil)
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+	unstructured.RemoveNestedField(unstructuredObj.Object, "spec", "backfillJobTemplateRef")
+	defaults := &v1.StreamDefaults{
+		StreamingJobTemplateRef: &corev1.ObjectReference{Name: "streaming-template", Namespace: "default"},
+		BackfillJobTemplateRef:  &corev1.ObjectReference{Name: "backfill-template", Namespace: "default"},
+	}
+
+	// Act
+	errWithoutDefaults := NewUnstructuredWrapper(unstructuredObj.DeepCopy()).Validate()
+	wrapper := NewUnstructuredWrapperWithDefaults(&unstructuredObj, defaults)
+	err = wrapper.Validate()
+
+	// Assert
+	require.Error(t, errWithoutDefaults)
+	require.NoError(t, err)
+	require.Equal(t, types.NamespacedName{Name: "jobTemplate1", Namespace: "default"}, wrapper.GetJobTemplate(nil))
+	require.Equal(t, types.NamespacedName{Name: "backfill-template", Namespace: "default"}, wrapper.GetJobTemplate(&v1.BackfillRequest{}))
+	require.Equal(t, "backfill-template", wrapper.GetEffectiveSettings().BackfillJobTemplateRef.Name)
+}
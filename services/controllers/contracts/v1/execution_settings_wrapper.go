@@ -55,11 +55,22 @@ type ExecutionSettingsWrapper struct {
 	underlyingSpec struct {
 		ExecutionSettings ExecutionSettings `json:"execution"`
 	}
+
+	// effective holds the execution settings with the class defaults applied, the spec is never changed by defaults
+	effective ExecutionSettings
+	defaults  *v1.StreamDefaults
 }
 
 func NewExecutionSettings(u *unstructured.Unstructured) *ExecutionSettingsWrapper {
+	return NewExecutionSettingsWithDefaults(u, nil)
+}
+
+// NewExecutionSettingsWithDefaults creates a new ExecutionSettingsWrapper applying the defaults of the stream class
+// to the execution settings left empty in the stream definition.
+func NewExecutionSettingsWithDefaults(u *unstructured.Unstructured, defaults *v1.StreamDefaults) *ExecutionSettingsWrapper {
 	w := &ExecutionSettingsWrapper{
 		Underlying: u,
+		defaults:   defaults,
 	}
 	w.SecretReferenceReader = common.NewSecretReferenceReader(u)
 	w.ConfiguratorProvider = common.NewConfiguratorProvider(u, w)
@@ -74,6 +85,7 @@ func (e *ExecutionSettingsWrapper) Suspended() bool {
 
 func (e *ExecutionSettingsWrapper) SetSuspended(suspended bool) error {
 	e.underlyingSpec.ExecutionSettings.Suspended = suspended
+	e.effective.Suspended = suspended
 	err := e.deserializeTo(e.Underlying)
 	if err != nil { // coverage-ignore
 		return err
@@ -100,21 +112,21 @@ func (e *ExecutionSettingsWrapper) StateString() string {
 func (e *ExecutionSettingsWrapper) GetJobTemplate(request *v1.BackfillRequest) types.NamespacedName {
 	if request != nil {
 		return types.NamespacedName{
-			Name:      e.underlyingSpec.ExecutionSettings.BackfillJobTemplateRef.Name,
-			Namespace: e.underlyingSpec.ExecutionSettings.BackfillJobTemplateRef.Namespace,
+			Name:      e.effective.BackfillJobTemplateRef.Name,
+			Namespace: e.effective.BackfillJobTemplateRef.Namespace,
 		}
 	}
 
-	if e.underlyingSpec.ExecutionSettings.StreamingBackend.BatchJobBackend != nil {
+	if e.effective.StreamingBackend.BatchJobBackend != nil {
 		return types.NamespacedName{
-			Name:      e.underlyingSpec.ExecutionSettings.StreamingBackend.BatchJobBackend.JobTemplateRef.Name,
-			Namespace: e.underlyingSpec.ExecutionSettings.StreamingBackend.BatchJobBackend.JobTemplateRef.Namespace,
+			Name:      e.effective.StreamingBackend.BatchJobBackend.JobTemplateRef.Name,
+			Namespace: e.effective.StreamingBackend.BatchJobBackend.JobTemplateRef.Namespace,
 		}
 	}
 
 	return types.NamespacedName{
-		Name:      e.underlyingSpec.ExecutionSettings.StreamingBackend.CronJobBackend.JobTemplateRef.Name,
-		Namespace: e.underlyingSpec.ExecutionSettings.StreamingBackend.CronJobBackend.JobTemplateRef.Namespace,
+		Name:      e.effective.StreamingBackend.CronJobBackend.JobTemplateRef.Name,
+		Namespace: e.effective.StreamingBackend.CronJobBackend.JobTemplateRef.Namespace,
 	}
 }
 
@@ -144,17 +156,19 @@ func (e *ExecutionSettingsWrapper) Validate() error {
 		return err
 	}
 
-	if e.underlyingSpec.ExecutionSettings.BackfillJobTemplateRef == nil {
+	e.applyDefaults()
+
+	if e.effective.BackfillJobTemplateRef == nil {
 		return errors.New("backfillJobTemplateRef is nil in execution spec with layout version 1")
 	}
 	return nil
 }
 
 func (e *ExecutionSettingsWrapper) GetBackend() stream.Backend {
-	if e.underlyingSpec.ExecutionSettings.StreamingBackend.CronJobBackend != nil {
+	if e.effective.StreamingBackend.CronJobBackend != nil {
 		return stream.CronJob
 	}
-	if e.underlyingSpec.ExecutionSettings.StreamingBackend.BatchJobBackend != nil {
+	if e.effective.StreamingBackend.BatchJobBackend != nil {
 		return stream.BatchJob
 	}
 
@@ -192,7 +206,58 @@ func (e *ExecutionSettingsWrapper) GetSchedule() (string, error) {
 	if e.GetBackend() == stream.BatchJob {
 		return "", fmt.Errorf("schedule is not applicable for BatchJob backend")
 	}
-	return e.underlyingSpec.ExecutionSettings.StreamingBackend.CronJobBackend.Schedule, nil
+	return e.effective.StreamingBackend.CronJobBackend.Schedule, nil
+}
+
+func (e *ExecutionSettingsWrapper) GetEffectiveSettings() stream.EffectiveSettings {
+	settings := stream.EffectiveSettings{
+		Backend:                e.GetBackend(),
+		BackfillJobTemplateRef: e.effective.BackfillJobTemplateRef,
+	}
+	switch settings.Backend {
+	case stream.BatchJob:
+		settings.JobTemplateRef = &e.effective.StreamingBackend.BatchJobBackend.JobTemplateRef
+	case stream.CronJob:
+		settings.JobTemplateRef = &e.effective.StreamingBackend.CronJobBackend.JobTemplateRef
+		settings.Schedule = e.effective.StreamingBackend.CronJobBackend.Schedule
+	}
+	return settings
+}
+
+// applyDefaults computes the effective execution settings from the spec and the defaults of the stream class.
+func (e *ExecutionSettingsWrapper) applyDefaults() {
+	defaults := common.NewClassDefaults(e.defaults)
+	settings := e.underlyingSpec.ExecutionSettings
+	settings.BackfillJobTemplateRef = defaults.BackfillJobTemplateRef(settings.BackfillJobTemplateRef)
+
+	backend := settings.StreamingBackend
+	if backend.BatchJobBackend == nil && backend.CronJobBackend == nil {
+		switch defaults.Backend() {
+		case v1.StreamBackendBatchJob:
+			backend.BatchJobBackend = &BatchJobBackendSettings{}
+		case v1.StreamBackendCronJob:
+			backend.CronJobBackend = &CronJobBackendSettings{}
+		}
+	}
+
+	if backend.BatchJobBackend != nil {
+		batchJob := *backend.BatchJobBackend
+		batchJob.JobTemplateRef = *defaults.StreamingJobTemplateRef(&batchJob.JobTemplateRef)
+		backend.BatchJobBackend = &batchJob
+	}
+
+	if backend.CronJobBackend != nil {
+		cronJob := *backend.CronJobBackend
+		cronJob.JobTemplateRef = *defaults.StreamingJobTemplateRef(&cronJob.JobTemplateRef)
+		cronJob.Schedule = defaults.Schedule(cronJob.Schedule)
+		backend.CronJobBackend = &cronJob
+	}
+
+	settings.StreamingBackend = backend
+	e.effective = settings
+	if defaults.Applied() {
+		e.SetClassDefaults(e.GetEffectiveSettings())
+	}
 }
 
 func (e *ExecutionSettingsWrapper) deserializeTo(unstructured *unstructured.Unstructured) error {
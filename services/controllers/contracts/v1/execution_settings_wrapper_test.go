@@ -2,6 +2,7 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	testv2 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v2"
@@ -104,6 +105,36 @@ func Test_CurrentConfiguration(t *testing.T) {
 	require.NotEqual(t, currentConfig, updatedConfig)
 }
 
+func Test_CurrentConfiguration_BackfillRequestBounds(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(nil)
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+
+	wrapper := NewExecutionSettings(&unstructuredObj)
+	require.NoError(t, wrapper.Validate())
+
+	from := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	to := metav1.NewTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	request := &v1.BackfillRequest{Spec: v1.BackfillRequestSpec{StreamId: "stream", From: &from}}
+
+	// Act
+	openRange, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+
+	request.Spec.To = &to
+	boundedRange, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+
+	request.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"tables":["orders"]}`)}
+	withParameters, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+
+	// Assert
+	require.NotEqual(t, openRange, boundedRange)
+	require.NotEqual(t, boundedRange, withParameters)
+}
+
 func Test_LastAppliedConfiguration(t *testing.T) {
 	// Arrange
 	fakeClient := setupFakeClient(nil)
@@ -444,3 +475,87 @@ func getUnstructured(t *testing.T, fakeClient client.WithWatch) (unstructured.Un
 	require.NoError(t, err)
 	return unstructuredObj, err
 }
+
+func Test_ClassDefaults_Applied(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(nil)
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+	defaults := &v1.StreamDefaults{
+		StreamingJobTemplateRef: &corev1.ObjectReference{Name: "streaming-template", Namespace: "default"},
+		BackfillJobTemplateRef:  &corev1.ObjectReference{Name: "backfill-template", Namespace: "default"},
+		Backend:                 v1.StreamBackendCronJob,
+		Schedule:                "*/5 * * * *",
+	}
+
+	withoutDefaults := NewExecutionSettings(unstructuredObj.DeepCopy())
+	require.NoError(t, withoutDefaults.Validate())
+
+	// Act
+	wrapper := NewExecutionSettingsWithDefaults(&unstructuredObj, defaults)
+	err = wrapper.Validate()
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, stream.CronJob, wrapper.GetBackend())
+	require.Equal(t, types.NamespacedName{Name: "streaming-template", Namespace: "default"}, wrapper.GetJobTemplate(nil))
+	require.Equal(t, types.NamespacedName{Name: "backfill-template", Namespace: "default"}, wrapper.GetJobTemplate(&v1.BackfillRequest{}))
+	schedule, err := wrapper.GetSchedule()
+	require.NoError(t, err)
+	require.Equal(t, "*/5 * * * *", schedule)
+
+	settings := wrapper.GetEffectiveSettings()
+	require.Equal(t, stream.CronJob, settings.Backend)
+	require.Equal(t, "*/5 * * * *", settings.Schedule)
+
+	_, found, err := unstructured.NestedMap(unstructuredObj.Object, "spec", "execution", "streamingBackend", "batch")
+	require.NoError(t, err)
+	require.False(t, found, "defaults must not be written to the stream spec")
+
+	configuration, err := wrapper.CurrentConfiguration(nil)
+	require.NoError(t, err)
+	configurationWithoutDefaults, err := withoutDefaults.CurrentConfiguration(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, configurationWithoutDefaults, configuration)
+}
+
+func Test_ClassDefaults_NotOverridingSpec(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(func(sd *testv2.MockStreamDefinition) {
+		sd.Spec.ExecutionSettings = testv2.ExecutionSettings{
+			LayoutVersion:          "v1",
+			BackfillJobTemplateRef: &corev1.ObjectReference{Name: "backfillJobTemplate1", Namespace: "default"},
+			StreamingBackend: testv2.StreamingBackend{
+				BatchJobBackend: &testv2.BatchJobBackend{
+					JobTemplateRef: corev1.ObjectReference{Name: "jobTemplate1", Namespace: "default"},
+				},
+			},
+		}
+	})
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+	defaults := &v1.StreamDefaults{
+		StreamingJobTemplateRef: &corev1.ObjectReference{Name: "streaming-template", Namespace: "default"},
+		BackfillJobTemplateRef:  &corev1.ObjectReference{Name: "backfill-template", Namespace: "default"},
+		Backend:                 v1.StreamBackendCronJob,
+	}
+
+	withoutDefaults := NewExecutionSettings(unstructuredObj.DeepCopy())
+	require.NoError(t, withoutDefaults.Validate())
+
+	// Act
+	wrapper := NewExecutionSettingsWithDefaults(&unstructuredObj, defaults)
+	err = wrapper.Validate()
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, stream.BatchJob, wrapper.GetBackend())
+	require.Equal(t, types.NamespacedName{Name: "jobTemplate1", Namespace: "default"}, wrapper.GetJobTemplate(nil))
+	require.Equal(t, types.NamespacedName{Name: "backfillJobTemplate1", Namespace: "default"}, wrapper.GetJobTemplate(&v1.BackfillRequest{}))
+
+	configuration, err := wrapper.CurrentConfiguration(nil)
+	require.NoError(t, err)
+	configurationWithoutDefaults, err := withoutDefaults.CurrentConfiguration(nil)
+	require.NoError(t, err)
+	require.Equal(t, configurationWithoutDefaults, configuration)
+}
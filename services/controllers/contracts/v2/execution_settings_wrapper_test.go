@@ -2,6 +2,7 @@ package v2
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	testv2 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v2"
@@ -104,6 +105,58 @@ func Test_CurrentConfiguration(t *testing.T) {
 	require.NotEqual(t, currentConfig, updatedConfig)
 }
 
+func Test_CurrentConfiguration_Ignores_Backfill_Retry_Policy(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(nil)
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+
+	wrapper := NewExecutionSettings(&unstructuredObj)
+	require.NoError(t, wrapper.Validate())
+	request := &v1.BackfillRequest{Spec: v1.BackfillRequestSpec{StreamClass: "class", StreamId: "stream", Partitions: 2}}
+
+	// Act
+	configuration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+	request.Spec.RetryPolicy = &v1.BackfillRetryPolicy{MaxAttempts: 5}
+	retriedConfiguration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+	request.Spec.Partitions = 4
+	repartitionedConfiguration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, configuration, retriedConfiguration)
+	require.NotEqual(t, configuration, repartitionedConfiguration)
+}
+
+func Test_CurrentConfiguration_Backfill_Duration(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(nil)
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+
+	wrapper := NewExecutionSettings(&unstructuredObj)
+	require.NoError(t, wrapper.Validate())
+	request := &v1.BackfillRequest{Spec: v1.BackfillRequestSpec{StreamClass: "class", StreamId: "stream"}}
+
+	// Act
+	configuration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+	request.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	request.Spec.Cancel = true
+	request.Spec.Completed = true
+	extendedConfiguration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+	wrapper.SetClassMaxBackfillDuration(&metav1.Duration{Duration: 2 * time.Hour})
+	classConfiguration, err := wrapper.CurrentConfiguration(request)
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, configuration, extendedConfiguration)
+	require.NotEqual(t, configuration, classConfiguration)
+}
+
 func Test_LastAppliedConfiguration(t *testing.T) {
 	// Arrange
 	fakeClient := setupFakeClient(nil)
@@ -439,3 +492,42 @@ func getUnstructured(t *testing.T, fakeClient client.WithWatch) (unstructured.Un
 	require.NoError(t, err)
 	return unstructuredObj, err
 }
+
+func Test_ClassDefaults_Applied(t *testing.T) {
+	// Arrange
+	fakeClient := setupFakeClient(func(sd *testv2.MockStreamDefinition) {
+		sd.Spec.ExecutionSettings = testv2.ExecutionSettings{
+			LayoutVersion: "v2",
+			StreamingBackend: testv2.StreamingBackend{
+				BatchJobBackend: &testv2.BatchJobBackend{},
+			},
+		}
+	})
+	unstructuredObj, err := getUnstructured(t, fakeClient)
+	require.NoError(t, err)
+	defaults := &v1.StreamDefaults{
+		StreamingJobTemplateRef: &corev1.ObjectReference{Name: "streaming-template", Namespace: "default"},
+		BackfillJobTemplateRef:  &corev1.ObjectReference{Name: "backfill-template", Namespace: "default"},
+	}
+
+	// Act
+	withoutDefaults := NewExecutionSettings(unstructuredObj.DeepCopy())
+	errWithoutDefaults := withoutDefaults.Validate()
+	wrapper := NewExecutionSettingsWithDefaults(&unstructuredObj, defaults)
+	err = wrapper.Validate()
+
+	// Assert
+	require.Error(t, errWithoutDefaults)
+	require.NoError(t, err)
+	require.Equal(t, stream.BatchJob, wrapper.GetBackend())
+	require.Equal(t, types.NamespacedName{Name: "streaming-template", Namespace: "default"}, wrapper.GetJobTemplate(nil))
+	require.Equal(t, types.NamespacedName{Name: "backfill-template", Namespace: "default"}, wrapper.GetJobTemplate(&v1.BackfillRequest{}))
+
+	settings := wrapper.GetEffectiveSettings()
+	require.Equal(t, "streaming-template", settings.JobTemplateRef.Name)
+	require.Equal(t, "backfill-template", settings.BackfillJobTemplateRef.Name)
+
+	_, found, err := unstructured.NestedMap(unstructuredObj.Object, "spec", "execution", "streamingBackend", "changeCapture", "backfillJobTemplateRef")
+	require.NoError(t, err)
+	require.False(t, found, "defaults must not be written to the stream spec")
+}
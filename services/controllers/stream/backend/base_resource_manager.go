@@ -9,6 +9,7 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/job"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -38,12 +39,7 @@ func (j *BaseResourceManager) Remove(ctx context.Context, object client.Object,
 func (j *BaseResourceManager) BuildJob(ctx context.Context, definition stream.Definition, request *v1.BackfillRequest, streamClass *v1.StreamClass, forceStreamingTemplate bool) (*batchv1.Job, error) {
 	logger := klog.FromContext(ctx)
 
-	var templateReference types.NamespacedName
-	if forceStreamingTemplate {
-		templateReference = definition.GetJobTemplate(nil)
-	} else {
-		templateReference = definition.GetJobTemplate(request)
-	}
+	templateReference := jobTemplate(definition, request, forceStreamingTemplate)
 
 	streamConfiguration, err := definition.CurrentConfiguration(request)
 	if err != nil { // coverage-ignore
@@ -68,12 +64,26 @@ func (j *BaseResourceManager) BuildJob(ctx context.Context, definition stream.De
 		return nil, err
 	}
 
+	podPolicyConfigurator, err := streamClass.Spec.PodPolicy.JobConfigurator()
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "failed to get pod policy configurator")
+		return nil, err
+	}
+
+	// The pod policy is applied first, so the labels and annotations managed by the operator take precedence
 	combinedConfigurator := job.NewConfiguratorChainBuilder().
+		WithConfigurator(podPolicyConfigurator).
 		WithConfigurator(definitionConfigurator).
 		WithConfigurator(backfillRequestConfigurator).
 		WithConfigurator(job.NewConfigurationChecksumConfigurator(streamConfiguration)).
+		WithConfigurator(job.NewReferencedSecretsHashConfigurator(definition.ReferencedSecretsHash())).
 		WithConfigurator(secretsConfigurator)
 
+	if !forceStreamingTemplate {
+		combinedConfigurator = combinedConfigurator.
+			WithConfigurator(job.NewActiveDeadlineConfigurator(request.EffectiveMaxDuration(streamClass)))
+	}
+
 	newJob, err := j.JobBuilder.BuildJob(ctx, templateReference, combinedConfigurator)
 	if err != nil { // coverage-ignore
 		logger.V(0).Error(err, "failed to build job")
@@ -85,3 +95,74 @@ func (j *BaseResourceManager) BuildJob(ctx context.Context, definition stream.De
 	}
 	return newJob, nil
 }
+
+// Preflight checks that the job template, the referenced secrets and config maps and the service account required to build the job
+// of the stream exist. A *stream.PreflightError is returned if any of them is missing.
+func (j *BaseResourceManager) Preflight(ctx context.Context, definition stream.Definition, request *v1.BackfillRequest, streamClass *v1.StreamClass, forceStreamingTemplate bool) error {
+	templateReference := jobTemplate(definition, request, forceStreamingTemplate)
+	template := v1.StreamingJobTemplate{}
+	err := j.Client.Get(ctx, templateReference, &template)
+	if errors.IsNotFound(err) {
+		return &stream.PreflightError{
+			Reason:  stream.TemplateNotFoundReason,
+			Message: fmt.Sprintf("The job template %s does not exist", templateReference),
+		}
+	}
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to get job template %s: %w", templateReference, err)
+	}
+
+	namespace := definition.NamespacedName().Namespace
+	for _, name := range stream.ReferencedSecretNames(streamClass, definition) {
+		err = j.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &corev1.Secret{})
+		if errors.IsNotFound(err) {
+			return &stream.PreflightError{
+				Reason:  stream.SecretNotFoundReason,
+				Message: fmt.Sprintf("The secret %s/%s referenced by the stream does not exist", namespace, name),
+			}
+		}
+		if err != nil { // coverage-ignore
+			return fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	for _, name := range stream.ReferencedConfigMapNames(streamClass, definition) {
+		err = j.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &corev1.ConfigMap{})
+		if errors.IsNotFound(err) {
+			return &stream.PreflightError{
+				Reason:  stream.ConfigMapNotFoundReason,
+				Message: fmt.Sprintf("The config map %s/%s referenced by the stream does not exist", namespace, name),
+			}
+		}
+		if err != nil { // coverage-ignore
+			return fmt.Errorf("failed to get config map %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	serviceAccountName := template.Spec.Spec.Template.Spec.ServiceAccountName
+	if streamClass.Spec.PodPolicy != nil && streamClass.Spec.PodPolicy.ServiceAccountName != "" {
+		serviceAccountName = streamClass.Spec.PodPolicy.ServiceAccountName
+	}
+	if serviceAccountName == "" {
+		return nil
+	}
+
+	err = j.Client.Get(ctx, types.NamespacedName{Name: serviceAccountName, Namespace: namespace}, &corev1.ServiceAccount{})
+	if errors.IsNotFound(err) {
+		return &stream.PreflightError{
+			Reason:  stream.ServiceAccountNotFoundReason,
+			Message: fmt.Sprintf("The service account %s/%s does not exist", namespace, serviceAccountName),
+		}
+	}
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to get service account %s/%s: %w", namespace, serviceAccountName, err)
+	}
+	return nil
+}
+
+func jobTemplate(definition stream.Definition, request *v1.BackfillRequest, forceStreamingTemplate bool) types.NamespacedName {
+	if forceStreamingTemplate {
+		return definition.GetJobTemplate(nil)
+	}
+	return definition.GetJobTemplate(request)
+}
@@ -2,6 +2,7 @@ package cron_job
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
@@ -11,6 +12,7 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/job"
 	"github.com/SneaksAndData/arcane-operator/services/watchers"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -52,11 +55,11 @@ func NewCronJobBackend(client client.Client, jobBuilder stream.JobBuilder, event
 	}
 }
 
-func (c *Backend) SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind) error { // coverage-ignore
+func (c *Backend) SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind, scope *stream.StreamClassScope) error { // coverage-ignore
 	primaryResource := &unstructured.Unstructured{}
 	primaryResource.SetGroupVersionKind(primaryGvk)
 	return watchers.NewTypedSecondaryWatcherBuilder[*batchv1.CronJob]().
-		WithFilter(NewPredicate()).
+		WithFilter(predicate.And[*batchv1.CronJob](NewPredicate(), stream.NewNamespaceScopePredicate[*batchv1.CronJob](scope))).
 		WithCache(cache).
 		WithHandler(handler.TypedEnqueueRequestForOwner[*batchv1.CronJob](scheme, mapper, primaryResource, handler.OnlyControllerOwner())).
 		Build().
@@ -97,8 +100,9 @@ func (c *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 		return reconcile.Result{}, fmt.Errorf("failed to fetch cronjob: %w", err)
 	}
 
-	if !apierrors.IsNotFound(err) {
-		equals, err := c.CompareConfigurations(ctx, object, definition, FromResource)
+	found := !apierrors.IsNotFound(err)
+	if found {
+		equals, err := c.CompareConfigurations(ctx, object, definition, streamClass, FromResource)
 		if err != nil { // coverage-ignore
 			return reconcile.Result{}, err
 		}
@@ -107,7 +111,20 @@ func (c *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 			logger.V(0).Info("The job already exists with matching configuration, skipping creation")
 			return c.statusManager.UpdateStreamPhase(ctx, definition, &v1.BackfillRequest{}, nextPhase, eventFunc)
 		}
+	}
+
+	// The outdated cron job is kept until the new one can be built
+	err = c.Preflight(ctx, definition, &v1.BackfillRequest{}, streamClass, true)
+	var failure *stream.PreflightError
+	if errors.As(err, &failure) {
+		c.EventRecorder.Eventf(definition.ToUnstructured(), corev1.EventTypeWarning, failure.Reason, failure.Message)
+		return c.statusManager.ReportPreflightFailure(ctx, definition, failure)
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
 
+	if found {
 		_, err = c.BaseResourceManager.Remove(ctx, object, nil)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to remove cron job: %w", err)
@@ -145,6 +162,14 @@ func (c *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 	object.Spec.Schedule = schedule
 	object.ResourceVersion = ""
 	object.Annotations[job.ConfigurationHashAnnotation] = configuration
+	delete(object.Annotations, job.ReferencedSecretsHashAnnotation)
+	if secretsHash := definition.ReferencedSecretsHash(); secretsHash != "" {
+		object.Annotations[job.ReferencedSecretsHashAnnotation] = secretsHash
+	}
+	delete(object.Annotations, job.PodPolicyHashAnnotation)
+	if podPolicyHash := j.Annotations[job.PodPolicyHashAnnotation]; podPolicyHash != "" {
+		object.Annotations[job.PodPolicyHashAnnotation] = podPolicyHash
+	}
 	object.OwnerReferences = []metav1.OwnerReference{
 		definition.ToOwnerReference(),
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	"github.com/SneaksAndData/arcane-operator/services/job"
 	"k8s.io/api/batch/v1"
@@ -33,6 +34,15 @@ func (j *BackendResource) CurrentConfiguration() (string, error) { // coverage-i
 	return value, nil
 }
 
+func (j *BackendResource) ReferencedSecretsHash() (string, bool) { // coverage-ignore (trivial)
+	value, ok := j.Annotations[job.ReferencedSecretsHashAnnotation]
+	return value, ok
+}
+
+func (j *BackendResource) PodPolicyHash() string { // coverage-ignore (trivial)
+	return j.Annotations[job.PodPolicyHashAnnotation]
+}
+
 func (j *BackendResource) IsCompleted() bool { // coverage-ignore (trivial)
 	return false
 }
@@ -53,6 +63,10 @@ func (j *BackendResource) IsBackfill() bool { // coverage-ignore (trivial)
 	return strings.ToLower(val) == "true"
 }
 
+func (j *BackendResource) BackfillProgress() (*streamingv1.BackfillProgress, error) { // coverage-ignore (trivial)
+	return nil, nil
+}
+
 func FromResource(cj client.Object) (stream.BackendResource, error) { // coverage-ignore (trivial)
 	cronJob, isCronJob := cj.(*v1.CronJob)
 
@@ -28,7 +28,7 @@ func NewEmptyBackend(eventRecorder record.EventRecorder) *Backend {
 	}
 }
 
-func (j *Backend) SetupWithController(_ cache.Cache, _ *runtime.Scheme, _ meta.RESTMapper, _ controller.Controller, _ schema.GroupVersionKind) error { // coverage-ignore (trivial)
+func (j *Backend) SetupWithController(_ cache.Cache, _ *runtime.Scheme, _ meta.RESTMapper, _ controller.Controller, _ schema.GroupVersionKind, _ *stream.StreamClassScope) error { // coverage-ignore (trivial)
 	return nil
 }
 
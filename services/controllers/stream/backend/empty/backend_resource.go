@@ -3,6 +3,7 @@ package empty
 import (
 	"fmt"
 
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	v1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +28,14 @@ func (j *BackendResource) CurrentConfiguration() (string, error) { // coverage-i
 	return "", nil
 }
 
+func (j *BackendResource) ReferencedSecretsHash() (string, bool) { // coverage-ignore (trivial)
+	return "", false
+}
+
+func (j *BackendResource) PodPolicyHash() string { // coverage-ignore (trivial)
+	return ""
+}
+
 func (j *BackendResource) IsCompleted() bool { // coverage-ignore (trivial)
 	return true
 }
@@ -43,6 +52,10 @@ func (j *BackendResource) IsBackfill() bool { // coverage-ignore (trivial)
 	return false
 }
 
+func (j *BackendResource) BackfillProgress() (*streamingv1.BackfillProgress, error) { // coverage-ignore (trivial)
+	return nil, nil
+}
+
 func FromResource(job client.Object) (stream.BackendResource, error) { // coverage-ignore (trivial)
 	jobObj, isJob := job.(*v1.Job)
 
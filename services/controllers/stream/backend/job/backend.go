@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"errors"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers"
@@ -9,7 +10,8 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend"
 	"github.com/SneaksAndData/arcane-operator/services/watchers"
 	batchv1 "k8s.io/api/batch/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -22,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -52,11 +55,11 @@ func NewJobBackend(client client.Client, jobBuilder stream.JobBuilder, eventReco
 	}
 }
 
-func (j *Backend) SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind) error {
+func (j *Backend) SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind, scope *stream.StreamClassScope) error {
 	primaryResource := &unstructured.Unstructured{}
 	primaryResource.SetGroupVersionKind(primaryGvk)
 	return watchers.NewTypedSecondaryWatcherBuilder[*batchv1.Job]().
-		WithFilter(NewPredicate()).
+		WithFilter(predicate.And[*batchv1.Job](NewPredicate(), stream.NewNamespaceScopePredicate[*batchv1.Job](scope))).
 		WithCache(cache).
 		WithHandler(handler.TypedEnqueueRequestForOwner[*batchv1.Job](scheme, mapper, primaryResource, handler.OnlyControllerOwner())).
 		Build().
@@ -73,7 +76,7 @@ func (j *Backend) Get(ctx context.Context, name types.NamespacedName) (stream.Ba
 		return nil, err
 	}
 
-	if errors.IsNotFound(err) {
+	if apierrors.IsNotFound(err) {
 		logger.V(0).Info("streaming does not exist")
 		return nil, nil
 	}
@@ -89,7 +92,12 @@ func (j *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 		return reconcile.Result{}, err
 	}
 
-	if errors.IsNotFound(err) {
+	if apierrors.IsNotFound(err) {
+		result, ok, err := j.preflight(ctx, definition, backfillRequest, streamClass)
+		if !ok {
+			return result, err
+		}
+
 		newJob, err := j.BuildJob(ctx, definition, backfillRequest, streamClass, false)
 		if err != nil { // coverage-ignore
 			logger.V(0).Error(err, "failed to build job for stream")
@@ -114,7 +122,7 @@ func (j *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 		return j.statusManager.UpdateStreamPhase(ctx, definition, backfillRequest, nextPhase, eventFunc)
 	}
 
-	equals, err := j.CompareConfigurations(ctx, &v1job, definition, FromResource)
+	equals, err := j.CompareConfigurations(ctx, &v1job, definition, streamClass, FromResource)
 	if err != nil { // coverage-ignore
 		return reconcile.Result{}, err
 	}
@@ -124,6 +132,12 @@ func (j *Backend) Apply(ctx context.Context, definition stream.Definition, backf
 		return j.statusManager.UpdateStreamPhase(ctx, definition, &v1.BackfillRequest{}, nextPhase, eventFunc)
 	}
 
+	// The outdated job is kept until the new one can be built
+	result, ok, err := j.preflight(ctx, definition, backfillRequest, streamClass)
+	if !ok {
+		return result, err
+	}
+
 	err = j.client.Delete(ctx, &v1job, client.PropagationPolicy(metav1.DeletePropagationBackground))
 	if client.IgnoreNotFound(err) != nil { // coverage-ignore
 		return reconcile.Result{}, err
@@ -159,6 +173,22 @@ func (j *Backend) NoOp(ctx context.Context, definition stream.Definition, backfi
 	return j.statusManager.UpdateStreamPhase(ctx, definition, backfillRequest, nextPhase, eventFunc)
 }
 
+// preflight validates the objects required to build the job of the stream. If the validation fails, the failure is
+// reported on the stream and the returned result requeues the stream with a backoff.
+func (j *Backend) preflight(ctx context.Context, definition stream.Definition, backfillRequest *v1.BackfillRequest, streamClass *v1.StreamClass) (reconcile.Result, bool, error) {
+	err := j.Preflight(ctx, definition, backfillRequest, streamClass, false)
+	var failure *stream.PreflightError
+	if errors.As(err, &failure) {
+		j.eventRecorder.Eventf(definition.ToUnstructured(), corev1.EventTypeWarning, failure.Reason, failure.Message)
+		result, err := j.statusManager.ReportPreflightFailure(ctx, definition, failure)
+		return result, false, err
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, false, err
+	}
+	return reconcile.Result{}, true, nil
+}
+
 func (j *Backend) getLogger(_ context.Context, request types.NamespacedName) klog.Logger {
 	return klog.Background().
 		WithName("job.Backend").
@@ -1,9 +1,11 @@
 package job
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
+	streamingv1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	"github.com/SneaksAndData/arcane-operator/services/job"
 	v1 "k8s.io/api/batch/v1"
@@ -33,6 +35,15 @@ func (j *BackendResource) CurrentConfiguration() (string, error) { // coverage-i
 	return value, nil
 }
 
+func (j *BackendResource) ReferencedSecretsHash() (string, bool) { // coverage-ignore (trivial)
+	value, ok := j.Annotations[job.ReferencedSecretsHashAnnotation]
+	return value, ok
+}
+
+func (j *BackendResource) PodPolicyHash() string { // coverage-ignore (trivial)
+	return j.Annotations[job.PodPolicyHashAnnotation]
+}
+
 func (j *BackendResource) IsCompleted() bool { // coverage-ignore (trivial)
 	for _, condition := range j.Status.Conditions {
 		if condition.Type == v1.JobComplete && condition.Status == "True" {
@@ -63,6 +74,23 @@ func (j *BackendResource) IsBackfill() bool { // coverage-ignore (trivial)
 	return strings.ToLower(val) == "true"
 }
 
+func (j *BackendResource) BackfillProgress() (*streamingv1.BackfillProgress, error) {
+	value, ok := j.Annotations[job.BackfillProgressAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	progress := &streamingv1.BackfillProgress{}
+	err := json.Unmarshal([]byte(value), progress)
+	if err != nil {
+		return nil, fmt.Errorf("job contains malformed backfill progress: %w", err)
+	}
+	if err := progress.Validate(); err != nil {
+		return nil, fmt.Errorf("job contains invalid backfill progress: %w", err)
+	}
+	return progress, nil
+}
+
 func FromResource(job client.Object) (stream.BackendResource, error) { // coverage-ignore (trivial)
 	jobObj, isJob := job.(*v1.Job)
 
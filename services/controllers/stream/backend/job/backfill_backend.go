@@ -10,6 +10,7 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend"
 	"github.com/SneaksAndData/arcane-operator/services/watchers"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -48,9 +50,9 @@ func NewBackfillBackendResourceManager(class *v1.StreamClass, client client.Clie
 	}
 }
 
-func (b *BackfillBackend) SetupWithController(cache cache.Cache, _ *runtime.Scheme, _ meta.RESTMapper, controller controller.Controller, _ schema.GroupVersionKind) error { // coverage-ignore (no need to test the wiring of the controller)
+func (b *BackfillBackend) SetupWithController(cache cache.Cache, _ *runtime.Scheme, _ meta.RESTMapper, controller controller.Controller, _ schema.GroupVersionKind, scope *stream.StreamClassScope) error { // coverage-ignore (no need to test the wiring of the controller)
 	return watchers.NewTypedSecondaryWatcherBuilder[*v1.BackfillRequest]().
-		WithFilter(NewBackfillRequestFilter(b.streamClass.Name)).
+		WithFilter(predicate.And(NewBackfillRequestFilter(b.streamClass.Name), stream.NewNamespaceScopePredicate[*v1.BackfillRequest](scope))).
 		WithCache(cache).
 		WithHandler(handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, obj *v1.BackfillRequest) []reconcile.Request {
 			return []reconcile.Request{{
@@ -103,12 +105,22 @@ func (b *BackfillBackend) Apply(ctx context.Context, definition stream.Definitio
 	logger := b.getLogger(ctx, definition.NamespacedName())
 	logger.V(2).Info("starting backfill by creating a backfill request")
 
+	// The status is not persisted on creation, the approval of a request approved by the operator is recorded separately
+	approvedBy := backfillRequest.Status.ApprovedBy
 	err := b.client.Create(ctx, backfillRequest)
 	if err != nil { // coverage-ignore
 		logger.V(0).Error(err, "failed to create backfill request")
 		return reconcile.Result{}, err
 	}
 
+	if approvedBy != "" {
+		err = b.ApproveRequest(ctx, backfillRequest, approvedBy, nil)
+		if err != nil { // coverage-ignore
+			logger.V(0).Error(err, "failed to approve backfill request")
+			return reconcile.Result{}, err
+		}
+	}
+
 	return b.statusManager.UpdateStreamPhase(ctx, definition, backfillRequest, nextPhase, eventFunc)
 }
 
@@ -142,18 +154,122 @@ func (b *BackfillBackend) Complete(ctx context.Context, definition stream.Defini
 	}
 
 	if request != nil {
+		err = b.UpdateRequestPhase(ctx, request, v1.BackfillRequestPhaseSucceeded, nil)
+		if err != nil { // coverage-ignore
+			return reconcile.Result{}, err
+		}
+	}
+
+	return b.statusManager.UpdateStreamPhase(ctx, definition, request, nextPhase, eventFunc)
+}
+
+func (b *BackfillBackend) UpdateRequestPhase(ctx context.Context, request *v1.BackfillRequest, phase v1.BackfillRequestPhase, eventFunc controllers.EventFunc) error {
+	if request.Status.Phase == phase { // coverage-ignore
+		return nil
+	}
+
+	if phase.IsTerminal() && !request.Spec.Completed {
 		request.Spec.Completed = true
 		err := b.client.Update(ctx, request)
 		if err != nil { // coverage-ignore
-			return reconcile.Result{}, err
+			return fmt.Errorf("failed to mark backfill request as completed: %w", err)
 		}
-		err = b.client.Status().Update(ctx, request)
+	}
+
+	request.Status.Phase = phase
+	err := b.client.Status().Update(ctx, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+
+	return nil
+}
+
+func (b *BackfillBackend) FailRequest(ctx context.Context, request *v1.BackfillRequest, reason string, message string, eventFunc controllers.EventFunc) error {
+	if !request.Spec.Completed {
+		request.Spec.Completed = true
+		err := b.client.Update(ctx, request)
 		if err != nil { // coverage-ignore
-			return reconcile.Result{}, err
+			return fmt.Errorf("failed to mark backfill request as completed: %w", err)
 		}
 	}
 
-	return b.statusManager.UpdateStreamPhase(ctx, definition, request, nextPhase, eventFunc)
+	request.Status.Phase = v1.BackfillRequestPhaseFailed
+	meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+		Type:    v1.BackfillFailedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+	err := b.client.Status().Update(ctx, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+
+	return nil
+}
+
+func (b *BackfillBackend) RecordRequestFailure(ctx context.Context, request *v1.BackfillRequest, jobUID types.UID, eventFunc controllers.EventFunc) error {
+	if jobUID != "" && request.Status.LastFailedJobUID == jobUID {
+		return nil
+	}
+
+	request.Status.FailedAttempts++
+	request.Status.LastFailureTime = new(metav1.Now())
+	request.Status.LastFailedJobUID = jobUID
+	// The progress belongs to the failed job, the next attempt reports its own
+	request.Status.Progress = nil
+	err := b.client.Status().Update(ctx, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+
+	return nil
+}
+
+func (b *BackfillBackend) ApproveRequest(ctx context.Context, request *v1.BackfillRequest, approver string, eventFunc controllers.EventFunc) error {
+	request.Status.ApprovedBy = approver
+	request.Status.ApprovalTime = new(metav1.Now())
+	err := b.client.Status().Update(ctx, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+
+	return nil
+}
+
+func (b *BackfillBackend) UpdateRequestProgress(ctx context.Context, request *v1.BackfillRequest, progress *v1.BackfillProgress, eventFunc controllers.EventFunc) error {
+	if equality.Semantic.DeepEqual(request.Status.Progress, progress) {
+		return nil
+	}
+
+	request.Status.Progress = progress
+	err := b.client.Status().Update(ctx, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to update backfill request status: %w", err)
+	}
+
+	if eventFunc != nil {
+		eventFunc()
+	}
+
+	return nil
 }
 
 func (b *BackfillBackend) getLogger(_ context.Context, request types.NamespacedName) klog.Logger { // coverage-ignore
@@ -24,9 +24,13 @@ func (j *BackfillRequestFilter) Delete(e event.TypedDeleteEvent[*v1.BackfillRequ
 	return !e.Object.Spec.Completed && e.Object.Spec.StreamClass == j.streamClass
 }
 
-// Update always returns false to ignore update events.
-func (j *BackfillRequestFilter) Update(_ event.TypedUpdateEvent[*v1.BackfillRequest]) bool { // coverage-ignore (trivial)
-	return false
+// Update filters BackfillRequests of the specified stream class that have just been cancelled or approved.
+func (j *BackfillRequestFilter) Update(e event.TypedUpdateEvent[*v1.BackfillRequest]) bool { // coverage-ignore (trivial)
+	cancelled := !e.ObjectOld.Spec.Cancel && e.ObjectNew.Spec.Cancel
+	approved := e.ObjectOld.ApprovedBy() == "" && e.ObjectNew.ApprovedBy() != ""
+	return !e.ObjectNew.Spec.Completed &&
+		e.ObjectNew.Spec.StreamClass == j.streamClass &&
+		(cancelled || approved)
 }
 
 // Generic always returns false to ignore generic events.
@@ -2,6 +2,7 @@ package job
 
 import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend"
+	"github.com/SneaksAndData/arcane-operator/services/job"
 	v1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
@@ -30,7 +31,12 @@ func (j *Predicate) Update(e event.TypedUpdateEvent[*v1.Job]) bool { // coverage
 		return false
 	}
 
-	return res.IsCompleted() || res.IsFailed()
+	return res.IsCompleted() || res.IsFailed() || progressChanged(e.ObjectOld, e.ObjectNew)
+}
+
+// progressChanged returns true if the backfill job has reported new progress.
+func progressChanged(oldJob *v1.Job, newJob *v1.Job) bool { // coverage-ignore (trivial)
+	return oldJob.Annotations[job.BackfillProgressAnnotation] != newJob.Annotations[job.BackfillProgressAnnotation]
 }
 
 func NewPredicate() predicate.TypedPredicate[*v1.Job] { // coverage-ignore (trivial)
@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog/v2"
@@ -32,7 +33,9 @@ func (c *ResourceReader) Get(ctx context.Context, name client.ObjectKey, object
 	return fromObject(object)
 }
 
-func (j *ResourceReader) CompareConfigurations(ctx context.Context, object client.Object, definition stream.Definition, fromObject ResourceConverter) (bool, error) {
+// CompareConfigurations returns true if the backend resource of the stream matches the configuration of the stream
+// definition, the pod policy of the stream class and the referenced secrets.
+func (j *ResourceReader) CompareConfigurations(ctx context.Context, object client.Object, definition stream.Definition, streamClass *v1.StreamClass, fromObject ResourceConverter) (bool, error) {
 	logger := klog.FromContext(ctx)
 
 	resource, err := j.Get(ctx, definition.NamespacedName(), object, fromObject)
@@ -51,5 +54,24 @@ func (j *ResourceReader) CompareConfigurations(ctx context.Context, object clien
 		logger.V(0).Error(err, "failed to extract configuration from stream definition")
 		return false, err
 	}
-	return configuration == definitionConfiguration, nil
+	if configuration != definitionConfiguration {
+		return false, nil
+	}
+
+	podPolicyHash, err := streamClass.Spec.PodPolicy.Hash()
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "failed to compute the hash of the pod policy")
+		return false, err
+	}
+	if resource.PodPolicyHash() != podPolicyHash {
+		return false, nil
+	}
+
+	// The secrets are compared only for the resources recording their hash, so the resources created before the
+	// hash was recorded are not replaced
+	secretsHash, found := resource.ReferencedSecretsHash()
+	if !found || definition.ReferencedSecretsHash() == "" {
+		return true, nil
+	}
+	return secretsHash == definition.ReferencedSecretsHash(), nil
 }
@@ -1,6 +1,7 @@
 package stream
 
 import (
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -17,6 +18,14 @@ type BackendResource interface {
 	// CurrentConfiguration returns the hash sum of the current configuration (spec) of the backend resource.
 	CurrentConfiguration() (string, error)
 
+	// ReferencedSecretsHash returns the hash of the data of the secrets referenced by the stream when the backend
+	// resource was created, and false if the backend resource does not record it.
+	ReferencedSecretsHash() (string, bool)
+
+	// PodPolicyHash returns the hash of the pod policy of the stream class enforced when the backend resource was
+	// created, or an empty string if no pod policy was enforced.
+	PodPolicyHash() string
+
 	// IsCompleted return true if the workload represented by the backend resource has completed successfully.
 	// e.g the job has completed with a Succeeded condition, or the cronjob has a last schedule time and no active jobs.
 	IsCompleted() bool
@@ -29,4 +38,8 @@ type BackendResource interface {
 
 	// IsBackfill returns true if the backend resource is associated with a backfill request.
 	IsBackfill() bool
+
+	// BackfillProgress returns the latest progress reported by the backfill workload, or nil if no progress
+	// has been reported yet.
+	BackfillProgress() (*v1.BackfillProgress, error)
 }
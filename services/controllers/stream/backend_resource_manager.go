@@ -18,7 +18,8 @@ import (
 type BackendResourceManager interface {
 
 	// SetupWithController sets up the necessary watches and handlers for the backend resources with the provided controller.
-	SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind) error
+	// Only the events of the backend resources in the namespaces of the given scope are watched.
+	SetupWithController(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper, controller controller.Controller, primaryGvk schema.GroupVersionKind, scope *StreamClassScope) error
 
 	// Get retrieves the current state of the backend resource associated with the given stream definition.
 	Get(ctx context.Context, key client.ObjectKey) (BackendResource, error)
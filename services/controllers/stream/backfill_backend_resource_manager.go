@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -17,4 +18,25 @@ type BackfillBackendResourceManager interface {
 	// Complete handles the completion of a backfill request for the given stream definition,
 	// transitioning to the next phase and invoking the provided event function.
 	Complete(ctx context.Context, definition Definition, nextPhase Phase, streamClass *v1.StreamClass, eventFunc controllers.EventFunc) (reconcile.Result, error)
+
+	// UpdateRequestPhase sets the phase of the given backfill request and invokes the provided event function.
+	// Requests moved to a terminal phase are marked as completed. The stream phase is not changed.
+	UpdateRequestPhase(ctx context.Context, request *v1.BackfillRequest, phase v1.BackfillRequestPhase, eventFunc controllers.EventFunc) error
+
+	// FailRequest marks the given backfill request as completed with the Failed phase, records the reason of the failure
+	// in its Failed condition and invokes the provided event function. The stream phase is not changed.
+	FailRequest(ctx context.Context, request *v1.BackfillRequest, reason string, message string, eventFunc controllers.EventFunc) error
+
+	// RecordRequestFailure increments the number of failed attempts of the given backfill request, clears the progress
+	// reported by the failed job and invokes the provided event function. A failure of the job with the given UID is
+	// only counted once. The phase of the request and the stream phase are not changed.
+	RecordRequestFailure(ctx context.Context, request *v1.BackfillRequest, jobUID types.UID, eventFunc controllers.EventFunc) error
+
+	// ApproveRequest records the approver and the approval time in the status of the given backfill request and
+	// invokes the provided event function. The phase of the request and the stream phase are not changed.
+	ApproveRequest(ctx context.Context, request *v1.BackfillRequest, approver string, eventFunc controllers.EventFunc) error
+
+	// UpdateRequestProgress records the progress reported by the backfill job in the status of the given backfill
+	// request. Nothing is updated if the progress has not changed.
+	UpdateRequestProgress(ctx context.Context, request *v1.BackfillRequest, progress *v1.BackfillProgress, eventFunc controllers.EventFunc) error
 }
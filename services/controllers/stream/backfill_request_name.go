@@ -0,0 +1,28 @@
+package stream
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// backfillRequestNameHashLength is the length of the hash suffix appended to the names of the generated backfill
+// requests.
+const backfillRequestNameHashLength = 8
+
+// BackfillRequestName returns the name of a backfill request generated from the given parts, e.g. the name of a
+// campaign and of a stream. The name is the parts joined by dashes, truncated to fit a DNS label and suffixed with a
+// hash of all parts so that it stays unique.
+func BackfillRequestName(parts ...string) string {
+	sum := md5.Sum([]byte(strings.Join(parts, "/")))
+	hash := hex.EncodeToString(sum[:])[:backfillRequestNameHashLength]
+
+	prefix := strings.Join(parts, "-")
+	if maxLength := validation.DNS1123LabelMaxLength - backfillRequestNameHashLength - 1; len(prefix) > maxLength {
+		prefix = strings.TrimRight(prefix[:maxLength], "-.")
+	}
+	return fmt.Sprintf("%s-%s", prefix, hash)
+}
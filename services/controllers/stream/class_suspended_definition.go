@@ -0,0 +1,15 @@
+package stream
+
+var _ Definition = (*classSuspendedDefinition)(nil)
+
+// classSuspendedDefinition reports a stream definition as suspended while its stream class is suspended.
+// The suspended flag in the spec of the stream is not changed, so the stream returns to the state defined by its own
+// spec when the stream class is resumed.
+type classSuspendedDefinition struct {
+	Definition
+}
+
+// Suspended always returns true since the stream class is suspended.
+func (d *classSuspendedDefinition) Suspended() bool {
+	return true
+}
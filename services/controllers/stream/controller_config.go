@@ -0,0 +1,117 @@
+package stream
+
+import (
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	defaultRateLimiterBaseDelay = 5 * time.Millisecond
+	defaultRateLimiterMaxDelay  = 1000 * time.Second
+	defaultRateLimiterQPS       = 10
+	defaultRateLimiterBurst     = 100
+)
+
+// ControllerConfig holds the settings of a stream controller. The operator-wide defaults are loaded from the
+// application configuration and can be overridden in the spec of each StreamClass.
+type ControllerConfig struct {
+	// MaxConcurrentReconciles is the maximum number of streams reconciled concurrently. Defaults to 1.
+	MaxConcurrentReconciles int `mapstructure:"max-concurrent-reconciles,omitempty"`
+
+	// RateLimiter holds the settings of the rate limiter of the stream controller queue.
+	RateLimiter RateLimiterConfig `mapstructure:"rate-limiter,omitempty"`
+
+	// ReconcileTimeout is the maximum duration of the reconciliation of a single stream. Not limited by default.
+	ReconcileTimeout time.Duration `mapstructure:"reconcile-timeout,omitempty"`
+
+	// ApprovalsVerified indicates that the admission webhook verifies the approvals of backfill requests. Backfills
+	// requiring an approval are never started otherwise. Set from the webhook configuration.
+	ApprovalsVerified bool `mapstructure:"-"`
+}
+
+// RateLimiterConfig holds the settings of the rate limiter of a stream controller queue.
+// Unset fields use the defaults of controller-runtime.
+type RateLimiterConfig struct {
+	// BaseDelay is the delay before retrying a failed reconciliation, doubled for every following failure.
+	BaseDelay time.Duration `mapstructure:"base-delay,omitempty"`
+
+	// MaxDelay is the upper limit of the delay before retrying a failed reconciliation.
+	MaxDelay time.Duration `mapstructure:"max-delay,omitempty"`
+
+	// QPS is the overall number of reconciliations per second.
+	QPS int `mapstructure:"qps,omitempty"`
+
+	// Burst is the size of the token bucket of the overall rate limit.
+	Burst int `mapstructure:"burst,omitempty"`
+}
+
+// ForStreamClass returns the configuration overridden with the controller settings of the stream class.
+func (c ControllerConfig) ForStreamClass(streamClass *v1.StreamClass) ControllerConfig {
+	settings := streamClass.Spec.Controller
+	if settings == nil {
+		return c
+	}
+
+	if settings.MaxConcurrentReconciles > 0 {
+		c.MaxConcurrentReconciles = int(settings.MaxConcurrentReconciles)
+	}
+	if settings.ReconcileTimeout != nil {
+		c.ReconcileTimeout = settings.ReconcileTimeout.Duration
+	}
+	if settings.RateLimiter == nil {
+		return c
+	}
+
+	if settings.RateLimiter.BaseDelay != nil {
+		c.RateLimiter.BaseDelay = settings.RateLimiter.BaseDelay.Duration
+	}
+	if settings.RateLimiter.MaxDelay != nil {
+		c.RateLimiter.MaxDelay = settings.RateLimiter.MaxDelay.Duration
+	}
+	if settings.RateLimiter.QPS > 0 {
+		c.RateLimiter.QPS = int(settings.RateLimiter.QPS)
+	}
+	if settings.RateLimiter.Burst > 0 {
+		c.RateLimiter.Burst = int(settings.RateLimiter.Burst)
+	}
+	return c
+}
+
+// ControllerOptions returns the options of an unmanaged stream controller built from the configuration.
+func (c ControllerConfig) ControllerOptions(reconciler reconcile.Reconciler) controller.Options {
+	return controller.Options{
+		Reconciler:              reconciler,
+		MaxConcurrentReconciles: c.MaxConcurrentReconciles,
+		RateLimiter:             c.RateLimiter.rateLimiter(),
+		ReconciliationTimeout:   c.ReconcileTimeout,
+		// The controller is recreated under the same name when the spec of its StreamClass changes
+		SkipNameValidation: new(true),
+	}
+}
+
+// rateLimiter returns the rate limiter built from the configuration. Like the default rate limiter of
+// controller-runtime, it combines a per-item exponential backoff with an overall token bucket.
+func (r RateLimiterConfig) rateLimiter() workqueue.TypedRateLimiter[reconcile.Request] {
+	baseDelay := valueOrDefault(r.BaseDelay, defaultRateLimiterBaseDelay)
+	maxDelay := valueOrDefault(r.MaxDelay, defaultRateLimiterMaxDelay)
+	qps := valueOrDefault(r.QPS, defaultRateLimiterQPS)
+	burst := valueOrDefault(r.Burst, defaultRateLimiterBurst)
+
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+	)
+}
+
+func valueOrDefault[T comparable](value T, defaultValue T) T {
+	var zero T
+	if value == zero {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+package stream
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_ControllerConfig_ForStreamClass_Defaults(t *testing.T) {
+	defaults := ControllerConfig{MaxConcurrentReconciles: 2, ReconcileTimeout: time.Minute}
+
+	config := defaults.ForStreamClass(&v1.StreamClass{})
+
+	require.Equal(t, defaults, config)
+}
+
+func Test_ControllerConfig_ForStreamClass_Overrides(t *testing.T) {
+	defaults := ControllerConfig{
+		MaxConcurrentReconciles: 2,
+		ReconcileTimeout:        time.Minute,
+		RateLimiter:             RateLimiterConfig{QPS: 5, Burst: 50},
+	}
+	sc := &v1.StreamClass{
+		Spec: v1.StreamClassSpec{
+			Controller: &v1.StreamControllerSettings{
+				MaxConcurrentReconciles: 8,
+				RateLimiter: &v1.RateLimiterSettings{
+					BaseDelay: &metav1.Duration{Duration: time.Second},
+					QPS:       20,
+				},
+			},
+		},
+	}
+
+	config := defaults.ForStreamClass(sc)
+
+	require.Equal(t, ControllerConfig{
+		MaxConcurrentReconciles: 8,
+		ReconcileTimeout:        time.Minute,
+		RateLimiter:             RateLimiterConfig{BaseDelay: time.Second, QPS: 20, Burst: 50},
+	}, config)
+}
+
+func Test_ControllerConfig_ControllerOptions(t *testing.T) {
+	config := ControllerConfig{
+		MaxConcurrentReconciles: 4,
+		ReconcileTimeout:        time.Minute,
+		RateLimiter:             RateLimiterConfig{BaseDelay: time.Second, MaxDelay: 3 * time.Second},
+	}
+
+	options := config.ControllerOptions(nil)
+
+	require.Equal(t, 4, options.MaxConcurrentReconciles)
+	require.Equal(t, time.Minute, options.ReconciliationTimeout)
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "stream"}}
+	require.Equal(t, time.Second, options.RateLimiter.When(request))
+	require.Equal(t, 2*time.Second, options.RateLimiter.When(request))
+	require.Equal(t, 3*time.Second, options.RateLimiter.When(request))
+}
@@ -5,6 +5,8 @@ import (
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,7 +34,11 @@ func NewDefaultStatusManager(client client.Client, gvk schema.GroupVersionKind,
 func (s *DefaultStatusManager) UpdateStreamPhase(ctx context.Context, definition Definition, backfillRequest *v1.BackfillRequest, next Phase, eventFunc controllers.EventFunc) (reconcile.Result, error) {
 	logger := klog.FromContext(ctx)
 
-	if definition.GetPhase() == next { // coverage-ignore
+	// The conditions of a failed pre-flight validation are cleared and the conditions reporting the backfill progress
+	// are refreshed even if the phase does not change. Refreshing the conditions alone does not emit an event.
+	_, preflightFailed := PreflightCondition(definition)
+	conditionsOnly := definition.GetPhase() == next && !preflightFailed
+	if conditionsOnly && sameConditions(streamConditions(definition), definition.ComputeConditions(backfillRequest)) { // coverage-ignore
 		logger.V(1).Info("Stream phase is already set", "phase", definition.GetPhase())
 		return reconcile.Result{}, nil
 	}
@@ -51,12 +57,25 @@ func (s *DefaultStatusManager) UpdateStreamPhase(ctx context.Context, definition
 		return reconcile.Result{}, err
 	}
 
+	err = applyReferencedSecretsHash(ctx, s.client, s.streamClass, definition)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to compute the hash of the referenced secrets")
+		return reconcile.Result{}, err
+	}
+	definition.SetClassMaxBackfillDuration(s.streamClass.Spec.MaxBackfillDuration)
+
 	err = definition.RecomputeConfiguration(backfillRequest)
 	if err != nil { // coverage-ignore
 		logger.V(0).Error(err, "unable to recompute Stream configuration hash")
 		return reconcile.Result{}, err
 	}
 
+	err = SetEffectiveSettings(definition)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to set Stream effective settings")
+		return reconcile.Result{}, err
+	}
+
 	err = definition.SetConditions(definition.ComputeConditions(backfillRequest))
 
 	if err != nil { // coverage-ignore
@@ -71,10 +90,66 @@ func (s *DefaultStatusManager) UpdateStreamPhase(ctx context.Context, definition
 		return reconcile.Result{}, err
 	}
 
-	if eventFunc != nil {
+	if eventFunc != nil && !conditionsOnly {
 		eventFunc()
 	}
 
 	return reconcile.Result{}, nil
 
 }
+
+func (s *DefaultStatusManager) ReportPreflightFailure(ctx context.Context, definition Definition, failure *PreflightError) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx)
+
+	// Refetch the definition to ensure we have the latest version before updating status
+	definition, err := GetStreamForClass(ctx, s.client, s.streamClass, definition.NamespacedName(), s.definitionParser)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to fetch Stream for status update")
+		return reconcile.Result{}, err
+	}
+
+	failingSince := metav1.Now()
+	if previous, found := PreflightCondition(definition); found && previous.Reason == failure.Reason {
+		failingSince = previous.LastTransitionTime
+	}
+
+	// The failure is merged into the conditions of the stream, its transition time is the time the failure with the
+	// same reason was first reported
+	conditions := streamConditions(definition)
+	meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:    "Error",
+		Status:  metav1.ConditionTrue,
+		Reason:  failure.Reason,
+		Message: failure.Message,
+	})
+	meta.FindStatusCondition(conditions, "Error").LastTransitionTime = failingSince
+	err = definition.SetConditions(conditions)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to set Stream conditions")
+		return reconcile.Result{}, err
+	}
+
+	err = s.client.Status().Update(ctx, definition.ToUnstructured().DeepCopy())
+	if err != nil { // coverage-ignore
+		logger.V(1).Error(err, "unable to update Stream status")
+		return reconcile.Result{}, err
+	}
+
+	backoff := preflightBackoff(failingSince.Time)
+	logger.V(0).Info("pre-flight validation of the stream failed, retrying later", "reason", failure.Reason, "backoff", backoff)
+	return reconcile.Result{RequeueAfter: backoff}, nil
+}
+
+// sameConditions returns true if both lists hold the same conditions, regardless of their transition times.
+func sameConditions(current []metav1.Condition, computed []metav1.Condition) bool {
+	if len(current) != len(computed) {
+		return false
+	}
+	for i := range current {
+		if current[i].Type != computed[i].Type || current[i].Status != computed[i].Status ||
+			current[i].Reason != computed[i].Reason || current[i].Message != computed[i].Message {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/SneaksAndData/arcane-operator/services/job"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// invalidBackfillProgress is the invalid progress last reported by the backfill job of a stream.
+type invalidBackfillProgress struct {
+	jobUID types.UID
+	value  string
+}
+
+// invalidBackfillProgressIndex remembers the invalid progress last reported for each stream, so that an invalid
+// progress annotation is reported once per value instead of on every reconciliation.
+type invalidBackfillProgressIndex struct {
+	lock    sync.Mutex
+	reports map[types.NamespacedName]invalidBackfillProgress
+}
+
+func newInvalidBackfillProgressIndex() *invalidBackfillProgressIndex {
+	return &invalidBackfillProgressIndex{reports: make(map[types.NamespacedName]invalidBackfillProgress)}
+}
+
+// record stores the invalid progress reported by the backfill job of the stream and returns true if it differs from
+// the invalid progress last recorded for the stream.
+func (i *invalidBackfillProgressIndex) record(stream types.NamespacedName, backfillJob BackendResource) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	report := invalidBackfillProgress{
+		jobUID: backfillJob.UID(),
+		value:  backfillJob.ToObject().GetAnnotations()[job.BackfillProgressAnnotation],
+	}
+	if last, ok := i.reports[stream]; ok && last == report {
+		return false
+	}
+	i.reports[stream] = report
+	return true
+}
+
+// remove forgets the invalid progress recorded for the stream.
+func (i *invalidBackfillProgressIndex) remove(stream types.NamespacedName) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	delete(i.reports, stream)
+}
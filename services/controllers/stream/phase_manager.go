@@ -12,5 +12,10 @@ import (
 type StatusManager interface {
 
 	// UpdateStreamPhase updates the phase of the stream definition's status and emits an event if the phase has changed.
+	// The status is also updated if the conditions computed for the backfill request have changed.
 	UpdateStreamPhase(ctx context.Context, definition Definition, backfillRequest *v1.BackfillRequest, next Phase, eventFunc controllers.EventFunc) (reconcile.Result, error)
+
+	// ReportPreflightFailure sets the condition describing the failed pre-flight validation on the stream definition
+	// without changing its phase, and returns the result requeueing the stream with a backoff.
+	ReportPreflightFailure(ctx context.Context, definition Definition, failure *PreflightError) (reconcile.Result, error)
 }
@@ -0,0 +1,82 @@
+package stream
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// TemplateNotFoundReason is the reason of the condition set when the job template of a stream does not exist
+	TemplateNotFoundReason = "TemplateNotFound"
+
+	// SecretNotFoundReason is the reason of the condition set when a secret referenced by a stream does not exist
+	SecretNotFoundReason = "SecretNotFound"
+
+	// ConfigMapNotFoundReason is the reason of the condition set when a config map referenced by a stream does not
+	// exist
+	ConfigMapNotFoundReason = "ConfigMapNotFound"
+
+	// ServiceAccountNotFoundReason is the reason of the condition set when the service account of the jobs of a
+	// stream does not exist
+	ServiceAccountNotFoundReason = "ServiceAccountNotFound"
+)
+
+const (
+	preflightMinBackoff = 10 * time.Second
+	preflightMaxBackoff = 5 * time.Minute
+)
+
+// PreflightError is returned when an object required to create the jobs of a stream does not exist.
+type PreflightError struct {
+	// Reason is the reason of the condition set on the stream
+	Reason string
+
+	// Message describes the missing object
+	Message string
+}
+
+func (e *PreflightError) Error() string {
+	return e.Message
+}
+
+// PreflightCondition returns the condition reporting a failed pre-flight validation of the stream definition, if any.
+func PreflightCondition(definition Definition) (*metav1.Condition, bool) {
+	for _, condition := range streamConditions(definition) {
+		switch condition.Reason {
+		case TemplateNotFoundReason, SecretNotFoundReason, ConfigMapNotFoundReason, ServiceAccountNotFoundReason:
+			return &condition, true
+		}
+	}
+	return nil, false
+}
+
+// streamConditions returns the conditions in the status of the stream definition.
+func streamConditions(definition Definition) []metav1.Condition {
+	items, found, err := unstructured.NestedSlice(definition.ToUnstructured().Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	conditions := make([]metav1.Condition, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok { // coverage-ignore
+			continue
+		}
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &condition); err != nil { // coverage-ignore
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// preflightBackoff returns the delay before validating the stream again. The delay grows with the time the validation
+// has been failing, so streams waiting for a missing object for a long time are checked less often.
+func preflightBackoff(failingSince time.Time) time.Duration {
+	return min(max(time.Since(failingSince), preflightMinBackoff), preflightMaxBackoff)
+}
@@ -0,0 +1,226 @@
+package stream
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/job"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// referencedSecret is the part of a secret included in the configuration of the streams referencing it.
+type referencedSecret struct {
+	Name string            `json:"name"`
+	Data map[string][]byte `json:"data"`
+}
+
+// ReferencedSecretNames returns the sorted names of the secrets referenced by the stream through the secret
+// references of the stream class. Fields missing in the stream spec are skipped.
+func ReferencedSecretNames(sc *v1.StreamClass, provider job.SecretReferenceProvider) []string {
+	fieldNames := slices.Clone(sc.Spec.SecretRefs)
+	for _, reference := range sc.Spec.SecretReferences {
+		if reference.Kind != v1.ReferencedObjectKindConfigMap {
+			fieldNames = append(fieldNames, reference.FieldName)
+		}
+	}
+	return referencedNames(fieldNames, provider)
+}
+
+// ReferencedConfigMapNames returns the sorted names of the config maps referenced by the stream through the secret
+// references of the stream class. Fields missing in the stream spec are skipped.
+func ReferencedConfigMapNames(sc *v1.StreamClass, provider job.SecretReferenceProvider) []string {
+	var fieldNames []string
+	for _, reference := range sc.Spec.SecretReferences {
+		if reference.Kind == v1.ReferencedObjectKindConfigMap {
+			fieldNames = append(fieldNames, reference.FieldName)
+		}
+	}
+	return referencedNames(fieldNames, provider)
+}
+
+// referencedNames returns the sorted names of the objects referenced by the given fields of the stream spec.
+func referencedNames(fieldNames []string, provider job.SecretReferenceProvider) []string {
+	var names []string
+	for _, fieldName := range fieldNames {
+		reference, err := provider.GetReferenceForSecret(fieldName)
+		if err != nil || reference.Name == "" {
+			continue
+		}
+		names = append(names, reference.Name)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// ReferencedSecretsHash returns the hash sum of the data of the secrets referenced by the stream definition, or an
+// empty string if the stream does not reference any existing secret. The secrets are not cached by the client of the
+// manager, so the data is read directly from the API server.
+func ReferencedSecretsHash(ctx context.Context, k8sClient client.Client, sc *v1.StreamClass, definition Definition) (string, error) {
+	var secrets []referencedSecret
+	for _, name := range ReferencedSecretNames(sc, definition) {
+		secret := corev1.Secret{}
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: definition.NamespacedName().Namespace}, &secret)
+		if client.IgnoreNotFound(err) != nil { // coverage-ignore
+			return "", err
+		}
+		if err != nil {
+			continue
+		}
+		secrets = append(secrets, referencedSecret{Name: name, Data: secret.Data})
+	}
+
+	if len(secrets) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(secrets)
+	if err != nil { // coverage-ignore
+		return "", err
+	}
+
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// applyReferencedSecretsHash sets the hash of the referenced secrets on the stream definition, unless the stream class
+// ignores the rotation of secrets.
+func applyReferencedSecretsHash(ctx context.Context, k8sClient client.Client, sc *v1.StreamClass, definition Definition) error {
+	if sc.Spec.IgnoreSecretRotation {
+		return nil
+	}
+
+	secretsHash, err := ReferencedSecretsHash(ctx, k8sClient, sc, definition)
+	if err != nil { // coverage-ignore
+		return err
+	}
+	definition.SetReferencedSecretsHash(secretsHash)
+	return nil
+}
+
+// referencedSecretIndex maps the secrets to the streams of a stream class referencing them. The index is filled when
+// the streams are reconciled, so the events of the secrets are mapped to the streams without listing them.
+type referencedSecretIndex struct {
+	mutex   sync.RWMutex
+	streams map[types.NamespacedName]sets.Set[types.NamespacedName]
+	secrets map[types.NamespacedName][]types.NamespacedName
+}
+
+func newReferencedSecretIndex() *referencedSecretIndex {
+	return &referencedSecretIndex{
+		streams: make(map[types.NamespacedName]sets.Set[types.NamespacedName]),
+		secrets: make(map[types.NamespacedName][]types.NamespacedName),
+	}
+}
+
+// update replaces the secrets referenced by the stream.
+func (i *referencedSecretIndex) update(stream types.NamespacedName, secretNames []string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.removeLocked(stream)
+	if len(secretNames) == 0 {
+		return
+	}
+
+	secrets := make([]types.NamespacedName, 0, len(secretNames))
+	for _, name := range secretNames {
+		secret := types.NamespacedName{Namespace: stream.Namespace, Name: name}
+		if i.streams[secret] == nil {
+			i.streams[secret] = sets.New[types.NamespacedName]()
+		}
+		i.streams[secret].Insert(stream)
+		secrets = append(secrets, secret)
+	}
+	i.secrets[stream] = secrets
+}
+
+// remove drops the stream from the index, e.g. when it has been deleted.
+func (i *referencedSecretIndex) remove(stream types.NamespacedName) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.removeLocked(stream)
+}
+
+func (i *referencedSecretIndex) removeLocked(stream types.NamespacedName) {
+	for _, secret := range i.secrets[stream] {
+		i.streams[secret].Delete(stream)
+		if i.streams[secret].Len() == 0 {
+			delete(i.streams, secret)
+		}
+	}
+	delete(i.secrets, stream)
+}
+
+// streamsReferencing returns the sorted names of the streams referencing the secret.
+func (i *referencedSecretIndex) streamsReferencing(secret types.NamespacedName) []types.NamespacedName {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return slices.SortedFunc(maps.Keys(i.streams[secret]), func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+}
+
+// streamsReferencingSecret maps a secret to the streams of the stream class referencing it.
+func (s *streamReconciler) streamsReferencingSecret(_ context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, stream := range s.referencedSecrets.streamsReferencing(client.ObjectKeyFromObject(secret)) {
+		requests = append(requests, reconcile.Request{NamespacedName: stream})
+	}
+	return requests
+}
+
+// referencedSecretPredicate passes the events of the secrets referenced by a stream of the stream class. Only the
+// metadata of the secrets is watched, so the updates are passed on any change of the resource version and the
+// reconciliation compares the hash of the secret data.
+func (s *streamReconciler) referencedSecretPredicate() predicate.TypedPredicate[*metav1.PartialObjectMetadata] { // coverage-ignore (requires a running controller)
+	return predicate.TypedFuncs[*metav1.PartialObjectMetadata]{
+		CreateFunc: func(e event.TypedCreateEvent[*metav1.PartialObjectMetadata]) bool {
+			return s.isReferencedSecret(e.Object)
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[*metav1.PartialObjectMetadata]) bool {
+			return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion() && s.isReferencedSecret(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[*metav1.PartialObjectMetadata]) bool {
+			return s.isReferencedSecret(e.Object)
+		},
+		GenericFunc: func(e event.TypedGenericEvent[*metav1.PartialObjectMetadata]) bool {
+			return false
+		},
+	}
+}
+
+// isReferencedSecret returns true if a stream of the stream class references the secret.
+func (s *streamReconciler) isReferencedSecret(secret *metav1.PartialObjectMetadata) bool {
+	return len(s.referencedSecrets.streamsReferencing(client.ObjectKeyFromObject(secret))) > 0
+}
+
+// secretMetadata returns the object used to watch the metadata of secrets.
+func secretMetadata() *metav1.PartialObjectMetadata {
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	return secret
+}
+
+// watchesSecrets returns true if the stream controller has to watch the secrets referenced by the streams.
+func (s *streamReconciler) watchesSecrets() bool {
+	if s.streamClass.Spec.IgnoreSecretRotation {
+		return false
+	}
+	return len(s.streamClass.Spec.SecretRefs) > 0 || len(s.streamClass.Spec.SecretReferences) > 0
+}
@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_ReferencedSecretIndex_Update(t *testing.T) {
+	// Arrange
+	index := newReferencedSecretIndex()
+	stream1 := types.NamespacedName{Namespace: "default", Name: "stream1"}
+	stream2 := types.NamespacedName{Namespace: "default", Name: "stream2"}
+
+	// Act
+	index.update(stream1, []string{"credentials", "certificates"})
+	index.update(stream2, []string{"credentials"})
+	index.update(stream1, []string{"certificates"})
+
+	// Assert
+	require.Equal(t, []types.NamespacedName{stream2}, index.streamsReferencing(types.NamespacedName{Namespace: "default", Name: "credentials"}))
+	require.Equal(t, []types.NamespacedName{stream1}, index.streamsReferencing(types.NamespacedName{Namespace: "default", Name: "certificates"}))
+	require.Empty(t, index.streamsReferencing(types.NamespacedName{Namespace: "other", Name: "credentials"}))
+}
+
+func Test_ReferencedSecretIndex_Remove(t *testing.T) {
+	// Arrange
+	index := newReferencedSecretIndex()
+	stream1 := types.NamespacedName{Namespace: "default", Name: "stream1"}
+	index.update(stream1, []string{"credentials"})
+
+	// Act
+	index.remove(stream1)
+
+	// Assert
+	require.Empty(t, index.streamsReferencing(types.NamespacedName{Namespace: "default", Name: "credentials"}))
+	require.Empty(t, index.streams)
+	require.Empty(t, index.secrets)
+}
+
+func Test_StreamsReferencingSecret(t *testing.T) {
+	// Arrange
+	reconciler := &streamReconciler{referencedSecrets: newReferencedSecretIndex()}
+	stream1 := types.NamespacedName{Namespace: "default", Name: "stream1"}
+	reconciler.referencedSecrets.update(stream1, []string{"credentials"})
+	secret := secretMetadata()
+	secret.SetNamespace("default")
+	secret.SetName("credentials")
+	otherSecret := secretMetadata()
+	otherSecret.SetNamespace("default")
+	otherSecret.SetName("other")
+
+	// Act
+	requests := reconciler.streamsReferencingSecret(t.Context(), secret)
+
+	// Assert
+	require.Equal(t, []reconcile.Request{{NamespacedName: stream1}}, requests)
+	require.True(t, reconciler.isReferencedSecret(secret))
+	require.False(t, reconciler.isReferencedSecret(otherSecret))
+}
@@ -0,0 +1,103 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// StreamClassScope selects the streams managed by a stream class with the namespace and stream selectors of the
+// class. A nil scope contains all streams.
+type StreamClassScope struct {
+	client            client.Client
+	namespaceSelector labels.Selector
+	streamSelector    labels.Selector
+}
+
+// NewStreamClassScope creates the scope of the given stream class.
+func NewStreamClassScope(client client.Client, streamClass *v1.StreamClass) (*StreamClassScope, error) {
+	namespaceSelector, err := selectorOrEverything(streamClass.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+
+	streamSelector, err := selectorOrEverything(streamClass.Spec.StreamSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stream selector: %w", err)
+	}
+
+	return &StreamClassScope{
+		client:            client,
+		namespaceSelector: namespaceSelector,
+		streamSelector:    streamSelector,
+	}, nil
+}
+
+// Contains returns true if the stream belongs to the scope of the stream class.
+func (s *StreamClassScope) Contains(ctx context.Context, stream client.Object) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+
+	if !s.streamSelector.Matches(labels.Set(stream.GetLabels())) {
+		return false, nil
+	}
+	return s.ContainsNamespace(ctx, stream.GetNamespace())
+}
+
+// ContainsNamespace returns true if the objects of the namespace belong to the scope of the stream class.
+func (s *StreamClassScope) ContainsNamespace(ctx context.Context, namespace string) (bool, error) {
+	if s == nil || s.namespaceSelector.Empty() {
+		return true, nil
+	}
+
+	ns := &corev1.Namespace{}
+	err := s.client.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	if err != nil {
+		return false, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	return s.namespaceSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// NewStreamScopePredicate returns a predicate that filters out the events of streams outside the scope.
+func NewStreamScopePredicate[T client.Object](scope *StreamClassScope) predicate.TypedPredicate[T] {
+	return predicate.NewTypedPredicateFuncs(func(obj T) bool {
+		return inScope(obj, scope.Contains)
+	})
+}
+
+// NewNamespaceScopePredicate returns a predicate that filters out the events of objects in namespaces outside the
+// scope. It is used by the watchers of the secondary resources of the streams.
+func NewNamespaceScopePredicate[T client.Object](scope *StreamClassScope) predicate.TypedPredicate[T] {
+	return predicate.NewTypedPredicateFuncs(func(obj T) bool {
+		return inScope(obj, func(ctx context.Context, obj client.Object) (bool, error) {
+			return scope.ContainsNamespace(ctx, obj.GetNamespace())
+		})
+	})
+}
+
+func inScope(obj client.Object, contains func(context.Context, client.Object) (bool, error)) bool {
+	ok, err := contains(context.Background(), obj)
+	if err != nil {
+		// The event is dropped, the object is checked again on the next event or resync
+		klog.Background().V(0).Error(err, "unable to check the scope of the stream class",
+			"namespace", obj.GetNamespace(), "name", obj.GetName())
+		return false
+	}
+	return ok
+}
+
+func selectorOrEverything(selector *metav1.LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
@@ -2,16 +2,23 @@ package stream
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/job"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ErrStreamOutOfScope is returned for the streams that are not selected by the namespace and stream selectors of their
+// stream class.
+var ErrStreamOutOfScope = errors.New("stream is not selected by the stream class")
+
 type Backend string
 
 const (
@@ -95,21 +102,115 @@ type Definition interface {
 	// GetSchedule returns the schedule string for a CronJob backend, if applicable. For non-CronJob backends,
 	// it can return an empty string or an error indicating that the schedule is not applicable.
 	GetSchedule() (string, error)
+
+	// GetEffectiveSettings returns the backend, job templates and schedule used for the stream after the defaults of
+	// the stream class have been applied.
+	GetEffectiveSettings() EffectiveSettings
+
+	// SetReferencedSecretsHash sets the hash of the data of the secrets referenced by the stream. The hash is kept
+	// apart from the configuration of the stream definition, an empty hash means the secrets are not tracked.
+	SetReferencedSecretsHash(hash string)
+
+	// ReferencedSecretsHash returns the hash set by SetReferencedSecretsHash.
+	ReferencedSecretsHash() string
+
+	// SetClassMaxBackfillDuration includes the maximum duration of the backfill jobs set by the stream class in the
+	// configuration of the backfill requests, since the deadline of the backfill jobs is derived from it.
+	SetClassMaxBackfillDuration(duration *metav1.Duration)
+}
+
+// EffectiveSettings are the settings used to run a stream, including the defaults of its stream class for the
+// settings left empty in the stream definition. They are written to the stream status for transparency, if the
+// stream CRD declares them.
+type EffectiveSettings struct {
+	// Backend is the streaming backend of the stream
+	Backend Backend `json:"backend,omitempty"`
+
+	// JobTemplateRef is the job template used for the streaming jobs
+	JobTemplateRef *corev1.ObjectReference `json:"jobTemplateRef,omitempty"`
+
+	// BackfillJobTemplateRef is the job template used for the backfill jobs
+	BackfillJobTemplateRef *corev1.ObjectReference `json:"backfillJobTemplateRef,omitempty"`
+
+	// Schedule is the schedule of the stream for the CronJob backend
+	Schedule string `json:"schedule,omitempty"`
 }
 
-// DefinitionParser is a function type that takes an unstructured object and returns a validated Definition or an
-// error if the parsing fails. This allows for flexible parsing logic that can be customized based on the specific
-// structure of the unstructured object.
-type DefinitionParser func(*unstructured.Unstructured) (Definition, error)
+// DefinitionParser is a function type that takes an unstructured object and the defaults of its stream class, and
+// returns a validated Definition or an error if the parsing fails. This allows for flexible parsing logic that can be
+// customized based on the specific structure of the unstructured object.
+type DefinitionParser func(obj *unstructured.Unstructured, defaults *v1.StreamDefaults) (Definition, error)
 
 // GetStreamForClass retrieves the stream definition for a given stream class and namespaced name.
+// Returns ErrStreamOutOfScope if the stream is not selected by the stream class.
 func GetStreamForClass(ctx context.Context, client client.Client, sc *v1.StreamClass, name types.NamespacedName, definitionParser DefinitionParser) (Definition, error) { // coverage-ignore
+	maybeSd, err := GetStreamResourceForClass(ctx, client, sc, name)
+	if err != nil {
+		return nil, err
+	}
+	return definitionParser(maybeSd, sc.Spec.Defaults)
+}
+
+// GetStreamResourceForClass retrieves the stream resource for a given stream class and namespaced name without
+// parsing it. Returns ErrStreamOutOfScope if the stream is not selected by the stream class.
+func GetStreamResourceForClass(ctx context.Context, client client.Client, sc *v1.StreamClass, name types.NamespacedName) (*unstructured.Unstructured, error) {
+	maybeSd := &unstructured.Unstructured{}
+	maybeSd.SetGroupVersionKind(sc.TargetResourceGvk())
+	err := client.Get(ctx, name, maybeSd)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := NewStreamClassScope(client, sc)
+	if err != nil { // coverage-ignore
+		return nil, err
+	}
+	inScope, err := scope.Contains(ctx, maybeSd)
+	if err != nil {
+		return nil, err
+	}
+	if !inScope {
+		return nil, fmt.Errorf("%w: %s is not selected by %s", ErrStreamOutOfScope, name, sc.Name)
+	}
+	return maybeSd, nil
+}
+
+// SetEffectiveSettings writes the effective settings of the stream definition to status.effectiveSettings.
+// The field is pruned by the API server unless the status schema of the stream CRD declares it, so the settings are
+// only informational and never read back by the operator.
+func SetEffectiveSettings(definition Definition) error {
+	settings, err := runtime.DefaultUnstructuredConverter.ToUnstructured(new(definition.GetEffectiveSettings()))
+	if err != nil { // coverage-ignore
+		return err
+	}
+	return unstructured.SetNestedMap(definition.ToUnstructured().Object, settings, "status", "effectiveSettings")
+}
+
+// ListStreamsForClass lists the stream resources managed by the given stream class.
+// Streams outside the namespace and stream selectors of the class are not returned.
+func ListStreamsForClass(ctx context.Context, k8sClient client.Client, sc *v1.StreamClass, opts ...client.ListOption) ([]unstructured.Unstructured, error) { // coverage-ignore
 	gvk := sc.TargetResourceGvk()
-	maybeSd := unstructured.Unstructured{}
-	maybeSd.SetGroupVersionKind(gvk)
-	err := client.Get(ctx, name, &maybeSd)
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	err := k8sClient.List(ctx, &list, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return definitionParser(&maybeSd)
+
+	scope, err := NewStreamClassScope(k8sClient, sc)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]unstructured.Unstructured, 0, len(list.Items))
+	for _, item := range list.Items {
+		inScope, err := scope.Contains(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		if inScope {
+			items = append(items, item)
+		}
+	}
+	return items, nil
 }
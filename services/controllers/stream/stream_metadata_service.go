@@ -19,6 +19,10 @@ func (s streamClassMetadataService) JobConfigurator() (job.Configurator, error)
 		builder = builder.WithConfigurator(job.NewSecretReferenceConfigurator(referenceFieldName, s.streamDefinition))
 	}
 
+	for _, reference := range s.streamClass.Spec.SecretReferences {
+		builder = builder.WithConfigurator(job.NewReferenceInjectionConfigurator(referenceInjection(reference), s.streamDefinition))
+	}
+
 	return builder.Build(), nil
 }
 
@@ -28,3 +32,17 @@ func NewStreamMetadataService(streamClass *v1.StreamClass, streamDefinition job.
 		streamDefinition: streamDefinition,
 	}
 }
+
+func referenceInjection(reference v1.SecretReference) job.ReferenceInjection {
+	keys := make([]job.KeyInjection, 0, len(reference.Keys))
+	for _, key := range reference.Keys {
+		keys = append(keys, job.KeyInjection{Key: key.Key, EnvName: key.EnvName})
+	}
+	return job.ReferenceInjection{
+		FieldName:  reference.FieldName,
+		ConfigMap:  reference.Kind == v1.ReferencedObjectKindConfigMap,
+		Keys:       keys,
+		MountPath:  reference.MountPath,
+		Containers: reference.Containers,
+	}
+}
@@ -2,16 +2,20 @@ package stream
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers"
-	"k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -36,41 +40,58 @@ type streamReconciler struct {
 	definitionParser               DefinitionParser
 	backendResourceManagers        map[Backend]BackendResourceManager
 	backfillBackendResourceManager BackfillBackendResourceManager
+	controllerConfig               ControllerConfig
+	scope                          *StreamClassScope
+	referencedSecrets              *referencedSecretIndex
+	invalidBackfillProgress        *invalidBackfillProgressIndex
 }
 
 func (s *streamReconciler) SetupUnmanaged(cache cache.Cache, scheme *runtime.Scheme, mapper meta.RESTMapper) (controller.Controller, error) { // coverage-ignore (setup is not tested in unit tests)
 	controllerName := s.streamClass.Name + "-controller"
-	newController, err := controller.NewUnmanaged(controllerName, controller.Options{Reconciler: s})
+	newController, err := controller.NewUnmanaged(controllerName, s.controllerConfig.ControllerOptions(s))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to start unmanaged stream controller: %w", err)
 	}
 
 	for backend, manager := range s.backendResourceManagers {
-		err = manager.SetupWithController(cache, scheme, mapper, newController, s.gvk)
+		err = manager.SetupWithController(cache, scheme, mapper, newController, s.gvk, s.scope)
 		if err != nil {
 			return nil, fmt.Errorf("failed to start backend resource watcher for backend %s: %w", backend, err)
 		}
 	}
 	resource := &unstructured.Unstructured{}
 	resource.SetGroupVersionKind(s.gvk)
-	newSource := source.Kind(cache, resource, &handler.TypedEnqueueRequestForObject[*unstructured.Unstructured]{})
+	newSource := source.Kind(cache, resource, &handler.TypedEnqueueRequestForObject[*unstructured.Unstructured]{},
+		NewStreamScopePredicate[*unstructured.Unstructured](s.scope))
 
 	err = newController.Watch(newSource)
 	if err != nil {
 		return nil, fmt.Errorf("failed to watch stream resource: %w", err)
 	}
 
-	err = s.backfillBackendResourceManager.SetupWithController(cache, scheme, mapper, newController, s.gvk)
+	err = s.backfillBackendResourceManager.SetupWithController(cache, scheme, mapper, newController, s.gvk, s.scope)
 	if err != nil {
 		return nil, fmt.Errorf("failed to watch backfills: %w", err)
 	}
 
+	if s.watchesSecrets() {
+		secretSource := source.Kind(cache, secretMetadata(),
+			handler.TypedEnqueueRequestsFromMapFunc(s.streamsReferencingSecret),
+			NewNamespaceScopePredicate[*metav1.PartialObjectMetadata](s.scope),
+			s.referencedSecretPredicate())
+		err = newController.Watch(secretSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch referenced secrets: %w", err)
+		}
+	}
+
 	return newController, nil
 }
 
 // NewStreamReconciler creates a new StreamReconciler instance.
-func NewStreamReconciler(client client.Client, gvk schema.GroupVersionKind, jobBuilder JobBuilder, streamClass *v1.StreamClass, eventRecorder record.EventRecorder, definitionParser DefinitionParser, managers map[Backend]BackendResourceManager, backfillResourceManager BackfillBackendResourceManager) controllers.UnmanagedReconciler {
+// The reconciler only manages the streams contained in the given scope, a nil scope contains all streams.
+func NewStreamReconciler(client client.Client, gvk schema.GroupVersionKind, jobBuilder JobBuilder, streamClass *v1.StreamClass, eventRecorder record.EventRecorder, definitionParser DefinitionParser, managers map[Backend]BackendResourceManager, backfillResourceManager BackfillBackendResourceManager, controllerConfig ControllerConfig, scope *StreamClassScope) controllers.UnmanagedReconciler {
 	return &streamReconciler{
 		gvk:                            gvk,
 		jobBuilder:                     jobBuilder,
@@ -80,6 +101,10 @@ func NewStreamReconciler(client client.Client, gvk schema.GroupVersionKind, jobB
 		definitionParser:               definitionParser,
 		backendResourceManagers:        managers,
 		backfillBackendResourceManager: backfillResourceManager,
+		controllerConfig:               controllerConfig,
+		scope:                          scope,
+		referencedSecrets:              newReferencedSecretIndex(),
+		invalidBackfillProgress:        newInvalidBackfillProgressIndex(),
 	}
 }
 
@@ -96,8 +121,17 @@ func (s *streamReconciler) Reconcile(ctx context.Context, request reconcile.Requ
 
 	streamDefinition, err := GetStreamForClass(ctx, s.client, s.streamClass, request.NamespacedName, s.definitionParser)
 
-	if errors.IsNotFound(err) { // coverage-ignore
+	if apierrors.IsNotFound(err) { // coverage-ignore
 		logger.V(0).Info("stream resource not found, might have been deleted")
+		s.referencedSecrets.remove(request.NamespacedName)
+		s.invalidBackfillProgress.remove(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if errors.Is(err, ErrStreamOutOfScope) {
+		logger.V(1).Info("stream is outside the scope of the stream class, skipping")
+		s.referencedSecrets.remove(request.NamespacedName)
+		s.invalidBackfillProgress.remove(request.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
@@ -106,12 +140,32 @@ func (s *streamReconciler) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
+	s.referencedSecrets.update(request.NamespacedName, ReferencedSecretNames(s.streamClass, streamDefinition))
+
+	err = applyReferencedSecretsHash(ctx, s.client, s.streamClass, streamDefinition)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "Unable to compute the hash of the referenced secrets")
+		return reconcile.Result{}, err
+	}
+	streamDefinition.SetClassMaxBackfillDuration(s.streamClass.Spec.MaxBackfillDuration)
+
+	if s.streamClass.Spec.Suspend {
+		logger.V(1).Info("stream class is suspended, treating the stream as suspended")
+		streamDefinition = &classSuspendedDefinition{Definition: streamDefinition}
+	}
+
 	backfillRequest, err := s.backfillBackendResourceManager.GetBackfillRequest(ctx, streamDefinition)
 	if client.IgnoreNotFound(err) != nil { // coverage-ignore
 		logger.V(0).Error(err, "unable to fetch BackfillRequest for the stream")
 		return reconcile.Result{}, err
 	}
 
+	backfillRequest, err = s.checkBackfillApproval(ctx, streamDefinition, backfillRequest)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to check the approval of the BackfillRequest")
+		return reconcile.Result{}, err
+	}
+
 	backendResource, err := s.backendResourceManagers[BatchJob].Get(ctx, request.NamespacedName)
 	if client.IgnoreNotFound(err) != nil { // coverage-ignore
 		logger.V(0).Error(err, "Unable to fetch backend resource for the stream")
@@ -138,6 +192,53 @@ func (s *streamReconciler) moveFsm(ctx context.Context, definition Definition, j
 	phase := definition.GetPhase()
 
 	switch {
+	case phase == Backfilling && backfillRequest != nil && backfillRequest.Spec.Cancel:
+		err := s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseCancelled, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Normal",
+				"BackfillCancelled",
+				"The backfill %s for stream %s has been cancelled", backfillRequest.Name, definition.NamespacedName().Name)
+		})
+		if err != nil {
+			logger.Error(err, "failed to cancel backfill request")
+			return reconcile.Result{}, err
+		}
+		return s.backfillBackendResourceManager.Remove(ctx, definition, Pending, nil)
+
+	case backfillRequest != nil && backfillRequest.Spec.Cancel:
+		// The backfill has not been started yet, so there is no backfill job to stop.
+		// Cancel the request and proceed as if there was no backfill requested.
+		err := s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseCancelled, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Normal",
+				"BackfillCancelled",
+				"The backfill %s for stream %s has been cancelled before it started", backfillRequest.Name, definition.NamespacedName().Name)
+		})
+		if err != nil {
+			logger.Error(err, "failed to cancel backfill request")
+			return reconcile.Result{}, err
+		}
+		return s.moveFsm(ctx, definition, job, nil)
+
+	case phase == Backfilling && job != nil && !job.IsCompleted() && backfillRequest != nil && s.backfillDeadlineExceeded(job, backfillRequest):
+		maxDuration := backfillRequest.EffectiveMaxDuration(s.streamClass)
+		err := s.backfillBackendResourceManager.FailRequest(ctx, backfillRequest, v1.BackfillDeadlineExceededReason,
+			fmt.Sprintf("The backfill job %s has exceeded the maximum duration of %s", job.Name(), maxDuration), nil)
+		if err != nil {
+			logger.Error(err, "failed to fail backfill request")
+			return reconcile.Result{}, err
+		}
+		return s.backfillBackendResourceManager.Remove(ctx, definition, Pending, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Warning",
+				"BackfillDeadlineExceeded",
+				"The backfill job %s has exceeded the maximum duration of %s, the backfill request %s is marked as failed",
+				job.Name(), maxDuration, backfillRequest.Name)
+		})
+
+	case phase == Backfilling && job != nil && job.IsFailed() && backfillRequest != nil:
+		return s.handleBackfillFailure(ctx, definition, job, backfillRequest)
+
 	case phase == Backfilling && job != nil && job.IsFailed():
 		return s.backfillBackendResourceManager.Remove(ctx, definition, Failed, func() {
 			s.eventRecorder.Eventf(definition.ToUnstructured(),
@@ -216,7 +317,36 @@ func (s *streamReconciler) moveFsm(ctx context.Context, definition Definition, j
 		return s.backendResourceManagers[definition.GetBackend()].Apply(ctx, definition, nil, nextPhase, s.streamClass, nil)
 
 	case phase == Pending && backfillRequest != nil:
+		if err := backfillRequest.Spec.Validate(); err != nil {
+			err = s.backfillBackendResourceManager.FailRequest(ctx, backfillRequest, v1.BackfillInvalidRangeReason, err.Error(), func() {
+				s.eventRecorder.Eventf(definition.ToUnstructured(),
+					"Warning",
+					"BackfillInvalidRange",
+					"The backfill request %s has an invalid time range and is marked as failed", backfillRequest.Name)
+			})
+			if err != nil {
+				logger.Error(err, "failed to fail backfill request")
+				return reconcile.Result{}, err
+			}
+			// The request will never run, proceed as if there was no backfill requested.
+			return s.moveFsm(ctx, definition, job, nil)
+		}
+
+		if wait := s.retryBackoffRemaining(backfillRequest); wait > 0 {
+			logger.V(0).Info("Waiting before retrying the backfill", "backoff", wait)
+			result, err := s.backendResourceManagers[BatchJob].NoOp(ctx, definition, backfillRequest, Pending, nil)
+			if err != nil {
+				return result, err
+			}
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+
 		logger.V(0).Info("Starting the backfill", "backend", definition.GetBackend())
+		err := s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseRunning, nil)
+		if err != nil {
+			logger.Error(err, "failed to update backfill request phase")
+			return reconcile.Result{}, err
+		}
 		return s.backendResourceManagers[BatchJob].Apply(ctx, definition, backfillRequest, Backfilling, s.streamClass, nil)
 
 	case phase == Running && definition.Suspended():
@@ -325,12 +455,25 @@ func (s *streamReconciler) moveFsm(ctx context.Context, definition Definition, j
 		})
 
 	case phase == Backfilling && !job.IsCompleted():
-		return s.backendResourceManagers[definition.GetBackend()].NoOp(ctx, definition, backfillRequest, Backfilling, func() {
+		err := s.updateBackfillProgress(ctx, definition, job, backfillRequest)
+		if err != nil {
+			logger.Error(err, "failed to update backfill progress")
+			return reconcile.Result{}, err
+		}
+		result, err := s.backendResourceManagers[definition.GetBackend()].NoOp(ctx, definition, backfillRequest, Backfilling, func() {
 			s.eventRecorder.Eventf(definition.ToUnstructured(),
 				"Normal",
 				"BackfillInProgress",
 				"Backfill for stream %s is still in progress", definition.NamespacedName().Name)
 		})
+		if err != nil {
+			return result, err
+		}
+		if deadline, ok := s.backfillDeadline(job, backfillRequest); ok {
+			// The job might not produce any event when the deadline is reached, so check it again in time
+			return reconcile.Result{RequeueAfter: time.Until(deadline)}, nil
+		}
+		return result, nil
 	case phase == Backfilling && definition.GetBackend() != BatchJob:
 		return s.backendResourceManagers[definition.GetBackend()].Remove(ctx, definition, Pending, func() {
 			s.eventRecorder.Eventf(definition.ToUnstructured(),
@@ -387,6 +530,163 @@ func (s *streamReconciler) moveFsm(ctx context.Context, definition Definition, j
 	)
 }
 
+// checkBackfillApproval returns the backfill request if it can be processed, or nil if the backfill has to be approved
+// first. Requests that have already started or have been cancelled are not checked. The approvals are refused if they
+// cannot be verified by the admission webhook.
+func (s *streamReconciler) checkBackfillApproval(ctx context.Context, definition Definition, backfillRequest *v1.BackfillRequest) (*v1.BackfillRequest, error) {
+	if backfillRequest == nil || backfillRequest.Spec.Cancel || s.trustedApproval(backfillRequest) {
+		return backfillRequest, nil
+	}
+	if backfillRequest.Status.Phase != v1.BackfillRequestPhaseNew && backfillRequest.Status.Phase != v1.BackfillRequestPhaseAwaitingApproval {
+		return backfillRequest, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	err := s.client.Get(ctx, types.NamespacedName{Name: backfillRequest.Namespace}, namespace)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", backfillRequest.Namespace, err)
+	}
+	if apierrors.IsNotFound(err) {
+		namespace = nil
+	}
+
+	if !s.streamClass.BackfillApprovalRequired(namespace) {
+		return backfillRequest, nil
+	}
+
+	if !s.controllerConfig.ApprovalsVerified {
+		klog.FromContext(ctx).V(0).Info("backfill requires an approval that cannot be verified without the admission webhook",
+			"backfillRequest", backfillRequest.Name)
+		err = s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseAwaitingApproval, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Warning",
+				"BackfillApprovalUnverified",
+				"The backfill %s for stream %s requires an approval, which cannot be verified while the admission webhook is disabled",
+				backfillRequest.Name, definition.NamespacedName().Name)
+		})
+		return nil, err
+	}
+
+	if approver := backfillRequest.ApprovedBy(); approver != "" {
+		err = s.backfillBackendResourceManager.ApproveRequest(ctx, backfillRequest, approver, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Normal",
+				"BackfillApproved",
+				"The backfill %s for stream %s has been approved by %s", backfillRequest.Name, definition.NamespacedName().Name, approver)
+		})
+		return backfillRequest, err
+	}
+
+	err = s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseAwaitingApproval, func() {
+		s.eventRecorder.Eventf(definition.ToUnstructured(),
+			"Normal",
+			"BackfillAwaitingApproval",
+			"The backfill %s for stream %s is waiting for approval", backfillRequest.Name, definition.NamespacedName().Name)
+	})
+	return nil, err
+}
+
+// trustedApproval returns true if the approval recorded in the status of the backfill request can be trusted: the
+// approvals by the operator itself, and the approvals verified by the admission webhook.
+func (s *streamReconciler) trustedApproval(backfillRequest *v1.BackfillRequest) bool {
+	switch backfillRequest.Status.ApprovedBy {
+	case "":
+		return false
+	case v1.OperatorApprover:
+		return true
+	}
+	return s.controllerConfig.ApprovalsVerified
+}
+
+// updateBackfillProgress mirrors the latest progress reported by the backfill job into the backfill request. The stream
+// conditions are refreshed by the following phase update. A malformed or out-of-range progress report is dropped and is
+// not an error, since it is produced by the job and not by the operator. It is reported once per annotation value.
+func (s *streamReconciler) updateBackfillProgress(ctx context.Context, definition Definition, job BackendResource, backfillRequest *v1.BackfillRequest) error {
+	progress, err := job.BackfillProgress()
+	if err != nil {
+		if !s.invalidBackfillProgress.record(definition.NamespacedName(), job) {
+			klog.FromContext(ctx).V(1).Info("backfill job still reports invalid progress", "job", job.Name(), "error", err.Error())
+			return nil
+		}
+		s.eventRecorder.Eventf(definition.ToUnstructured(),
+			"Warning",
+			"BackfillProgressInvalid",
+			"The backfill job %s reported invalid progress: %v", job.Name(), err)
+		return nil
+	}
+	s.invalidBackfillProgress.remove(definition.NamespacedName())
+	if progress == nil {
+		return nil
+	}
+
+	return s.backfillBackendResourceManager.UpdateRequestProgress(ctx, backfillRequest, progress, nil)
+}
+
+// backfillDeadline returns the time the backfill job exceeds the maximum duration of the backfill request, or false if
+// the backfill has no maximum duration.
+func (s *streamReconciler) backfillDeadline(job BackendResource, backfillRequest *v1.BackfillRequest) (time.Time, bool) {
+	maxDuration := backfillRequest.EffectiveMaxDuration(s.streamClass)
+	created := job.ToObject().GetCreationTimestamp()
+	if maxDuration <= 0 || created.IsZero() {
+		return time.Time{}, false
+	}
+	return created.Add(maxDuration), true
+}
+
+// backfillDeadlineExceeded returns true if the backfill job has run longer than the maximum duration of the request.
+func (s *streamReconciler) backfillDeadlineExceeded(job BackendResource, backfillRequest *v1.BackfillRequest) bool {
+	deadline, ok := s.backfillDeadline(job, backfillRequest)
+	return ok && !time.Now().Before(deadline)
+}
+
+// handleBackfillFailure removes the failed backfill job and either schedules another attempt according to the retry
+// policy of the backfill request, or marks the request as failed and returns the stream to its streaming backend.
+func (s *streamReconciler) handleBackfillFailure(ctx context.Context, definition Definition, job BackendResource, backfillRequest *v1.BackfillRequest) (reconcile.Result, error) {
+	policy := backfillRequest.EffectiveRetryPolicy(s.streamClass)
+
+	// The failure is counted once per job, so a retried reconciliation after a failed stream update does not
+	// count it again.
+	err := s.backfillBackendResourceManager.RecordRequestFailure(ctx, backfillRequest, job.UID(), nil)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	failedAttempts := backfillRequest.Status.FailedAttempts
+
+	if policy.CanRetry(failedAttempts) {
+		return s.backfillBackendResourceManager.Remove(ctx, definition, Pending, func() {
+			s.eventRecorder.Eventf(definition.ToUnstructured(),
+				"Warning",
+				"BackfillRetrying",
+				"The backfill job %s has failed (attempt %d of %d), retrying in %s",
+				job.Name(), failedAttempts, policy.MaxAttempts, policy.Backoff(failedAttempts))
+		})
+	}
+
+	err = s.backfillBackendResourceManager.UpdateRequestPhase(ctx, backfillRequest, v1.BackfillRequestPhaseFailed, nil)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return s.backfillBackendResourceManager.Remove(ctx, definition, Pending, func() {
+		s.eventRecorder.Eventf(definition.ToUnstructured(),
+			"Warning",
+			"BackfillFailed",
+			"The backfill job %s has failed after %d attempt(s), the backfill request %s is marked as failed",
+			job.Name(), failedAttempts, backfillRequest.Name)
+	})
+}
+
+// retryBackoffRemaining returns the time left until the next attempt of a previously failed backfill can be started.
+func (s *streamReconciler) retryBackoffRemaining(backfillRequest *v1.BackfillRequest) time.Duration {
+	if backfillRequest.Status.LastFailureTime == nil {
+		return 0
+	}
+
+	policy := backfillRequest.EffectiveRetryPolicy(s.streamClass)
+	retryAt := backfillRequest.Status.LastFailureTime.Add(policy.Backoff(backfillRequest.Status.FailedAttempts))
+	return time.Until(retryAt)
+}
+
 func tryTransitionBackend(ctx context.Context, s *streamReconciler, definition Definition, backfillRequest *v1.BackfillRequest) (bool, reconcile.Result, error) {
 	logger := klog.Background().
 		WithValues("namespace", definition.NamespacedName().Namespace).
@@ -477,5 +777,7 @@ func (s *streamReconciler) newBackfillRequest(definition Definition) *v1.Backfil
 			StreamId:    definition.NamespacedName().Name,
 			StreamClass: s.streamClass.Name,
 		},
+		// The initial backfill is part of the stream creation and is approved by the operator
+		Status: v1.BackfillRequestStatus{ApprovedBy: v1.OperatorApprover},
 	}
 }
@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +27,37 @@ func AssertStreamDefinitionPhase(t *testing.T, k8sClient client.Client, name typ
 	require.Equal(t, string(phase), sd.Status.Phase)
 }
 
+func AssertStreamDefinitionCondition(t *testing.T, k8sClient client.Client, name types.NamespacedName, reason string) {
+	sd := &testv2.MockStreamDefinition{}
+	err := k8sClient.Get(t.Context(), name, sd)
+	require.NoError(t, err)
+	require.True(t, slices.ContainsFunc(sd.Status.Conditions, func(c metav1.Condition) bool { return c.Reason == reason }),
+		"expected a condition with reason %s, got %v", reason, sd.Status.Conditions)
+}
+
+func AssertStreamDefinitionConditionMessage(t *testing.T, k8sClient client.Client, name types.NamespacedName, message string) {
+	sd := &testv2.MockStreamDefinition{}
+	err := k8sClient.Get(t.Context(), name, sd)
+	require.NoError(t, err)
+	require.True(t, slices.ContainsFunc(sd.Status.Conditions, func(c metav1.Condition) bool { return strings.Contains(c.Message, message) }),
+		"expected a condition with message containing %s, got %v", message, sd.Status.Conditions)
+}
+
+func AssertStreamDefinitionNoCondition(t *testing.T, k8sClient client.Client, name types.NamespacedName, reason string) {
+	sd := &testv2.MockStreamDefinition{}
+	err := k8sClient.Get(t.Context(), name, sd)
+	require.NoError(t, err)
+	require.False(t, slices.ContainsFunc(sd.Status.Conditions, func(c metav1.Condition) bool { return c.Reason == reason }),
+		"expected no condition with reason %s, got %v", reason, sd.Status.Conditions)
+}
+
+func AssertStreamDefinitionSuspendedSpec(t *testing.T, k8sClient client.Client, name types.NamespacedName, suspended bool) {
+	sd := &testv2.MockStreamDefinition{}
+	err := k8sClient.Get(t.Context(), name, sd)
+	require.NoError(t, err)
+	require.Equal(t, suspended, sd.Spec.ExecutionSettings.Suspended)
+}
+
 func AssertJobExists(t *testing.T, k8sClient client.Client, name types.NamespacedName) {
 	newJob := &batchv1.Job{}
 	err := k8sClient.Get(t.Context(), name, newJob)
@@ -77,6 +112,53 @@ func AssertBackfillRequestCompleted(t *testing.T, k8sClient client.Client, objec
 	require.True(t, backfillRequest.Spec.Completed)
 }
 
+func AssertBackfillRequestPhase(t *testing.T, k8sClient client.Client, objectName types.NamespacedName, phase v1.BackfillRequestPhase) {
+	backfillRequest := &v1.BackfillRequest{}
+	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: "backfill1", Namespace: objectName.Namespace}, backfillRequest)
+	require.NoError(t, err)
+	require.Equal(t, phase, backfillRequest.Status.Phase)
+}
+
+func AssertBackfillRequestFailedAttempts(t *testing.T, k8sClient client.Client, objectName types.NamespacedName, failedAttempts int32) {
+	backfillRequest := &v1.BackfillRequest{}
+	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: "backfill1", Namespace: objectName.Namespace}, backfillRequest)
+	require.NoError(t, err)
+	require.Equal(t, failedAttempts, backfillRequest.Status.FailedAttempts)
+	require.NotNil(t, backfillRequest.Status.LastFailureTime)
+}
+
+func AssertBackfillRequestApprovedBy(t *testing.T, k8sClient client.Client, objectName types.NamespacedName, approver string) {
+	backfillRequest := &v1.BackfillRequest{}
+	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: "backfill1", Namespace: objectName.Namespace}, backfillRequest)
+	require.NoError(t, err)
+	require.Equal(t, approver, backfillRequest.Status.ApprovedBy)
+	require.NotNil(t, backfillRequest.Status.ApprovalTime)
+}
+
+func AssertBackfillRequestProgress(t *testing.T, k8sClient client.Client, objectName types.NamespacedName, progress *v1.BackfillProgress) {
+	backfillRequest := &v1.BackfillRequest{}
+	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: "backfill1", Namespace: objectName.Namespace}, backfillRequest)
+	require.NoError(t, err)
+	if progress == nil {
+		require.Nil(t, backfillRequest.Status.Progress)
+		return
+	}
+	require.NotNil(t, backfillRequest.Status.Progress)
+	require.Equal(t, progress.Percent, backfillRequest.Status.Progress.Percent)
+	require.Equal(t, progress.Rows, backfillRequest.Status.Progress.Rows)
+	require.Equal(t, progress.Watermark, backfillRequest.Status.Progress.Watermark)
+}
+
+func AssertBackfillRequestFailedWithReason(t *testing.T, k8sClient client.Client, objectName types.NamespacedName, reason string) {
+	backfillRequest := &v1.BackfillRequest{}
+	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: "backfill1", Namespace: objectName.Namespace}, backfillRequest)
+	require.NoError(t, err)
+	require.Equal(t, v1.BackfillRequestPhaseFailed, backfillRequest.Status.Phase)
+	condition := meta.FindStatusCondition(backfillRequest.Status.Conditions, v1.BackfillFailedCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, reason, condition.Reason)
+}
+
 func AssertBackfillRequests(t *testing.T, k8sClient client.Client, verify func(request *v1.BackfillRequestList, err error)) {
 	backfillRequestList := &v1.BackfillRequestList{}
 	err := k8sClient.List(t.Context(), backfillRequestList)
@@ -8,13 +8,23 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/tests/helpers/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+// DefaultJobTemplates are the job templates seeded into every fake client created by SetupClientFromBuilders, so
+// the jobs of the streams referencing them pass the pre-flight validation.
+var DefaultJobTemplates = []types.NamespacedName{
+	{Name: "streaming-template", Namespace: "default"},
+	{Name: "backfill-template", Namespace: "default"},
+	{Name: "batch-template", Namespace: "default"},
+}
+
 // SetupClientFromBuilders constructs a fake controller-runtime client seeded with the *testv1.MockStreamDefinition
-// and/or *testv2.MockStreamDefinition produced by the provided builders.
+// and/or *testv2.MockStreamDefinition produced by the provided builders and the DefaultJobTemplates.
 func SetupClientFromBuilders(builderV1 *mockv1.MockStreamDefinitionBuilder, builderV2 *v2.MockStreamDefinitionBuilder, resources *FakeClientResourcesBuilder) client.Client {
 	scheme := runtime.NewScheme()
 	_ = testv1.AddToScheme(scheme)
@@ -24,6 +34,11 @@ func SetupClientFromBuilders(builderV1 *mockv1.MockStreamDefinitionBuilder, buil
 	_ = v1.AddToScheme(scheme)
 
 	clientBuilder := crfake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&v1.BackfillRequest{})
+	for _, template := range DefaultJobTemplates {
+		clientBuilder = clientBuilder.WithObjects(&v1.StreamingJobTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: template.Name, Namespace: template.Namespace},
+		})
+	}
 	if builderV1 != nil {
 		obj := builderV1.Build()
 		clientBuilder = clientBuilder.WithObjects(obj).WithStatusSubresource(&testv1.MockStreamDefinition{})
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"sync"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -11,6 +12,9 @@ import (
 	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+// FailedJobUID is the UID of the failed batch Job seeded by WithFailedJob.
+const FailedJobUID types.UID = "failed-job-uid"
+
 // FakeClientResourcesBuilder provides a fluent builder for accumulating
 // secondary Kubernetes resources (Jobs, CronJobs, BackfillRequests, ...) that
 // should be seeded into a controller-runtime fake client. The builder produces
@@ -50,6 +54,24 @@ func (b *FakeClientResourcesBuilder) WithOutdatedJob(n types.NamespacedName) *Fa
 	})
 }
 
+// WithProgressReportingJob seeds the fake client with a running backfill Job whose
+// backfill progress annotation is set to the provided value.
+func (b *FakeClientResourcesBuilder) WithProgressReportingJob(n types.NamespacedName, progress string) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: n.Namespace,
+				Name:      n.Name,
+				Labels:    map[string]string{"arcane/backfilling": "true"},
+				Annotations: map[string]string{
+					"configuration-hash":       "old-hash",
+					"arcane/backfill-progress": progress,
+				},
+			},
+		})
+	})
+}
+
 // WithOutdatedCronJob seeds the fake client with a CronJob whose
 // configuration-hash annotation is set to "old-hash".
 func (b *FakeClientResourcesBuilder) WithOutdatedCronJob(n types.NamespacedName) *FakeClientResourcesBuilder {
@@ -92,6 +114,7 @@ func (b *FakeClientResourcesBuilder) WithFailedJob(n types.NamespacedName) *Fake
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace:   n.Namespace,
 				Name:        n.Name,
+				UID:         FailedJobUID,
 				Annotations: map[string]string{"configuration-hash": "old-hash"},
 			},
 			Status: batchv1.JobStatus{
@@ -119,6 +142,23 @@ func (b *FakeClientResourcesBuilder) WithConsistentJob(n types.NamespacedName, h
 	})
 }
 
+// WithConsistentJobReferencingSecrets seeds the fake client with a batch Job whose configuration-hash annotation
+// matches the provided hash and which records the provided hash of the referenced secrets.
+func (b *FakeClientResourcesBuilder) WithConsistentJobReferencingSecrets(n types.NamespacedName, hash string, secretsHash string) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: n.Namespace,
+				Name:      n.Name,
+				Annotations: map[string]string{
+					"configuration-hash":             hash,
+					"arcane/referenced-secrets-hash": secretsHash,
+				},
+			},
+		})
+	})
+}
+
 // WithConsistentCronJob seeds the fake client with a CronJob whose
 // configuration-hash annotation matches the provided hash.
 func (b *FakeClientResourcesBuilder) WithConsistentCronJob(n types.NamespacedName, hash string) *FakeClientResourcesBuilder {
@@ -147,6 +187,170 @@ func (b *FakeClientResourcesBuilder) WithBackfillRequest(n types.NamespacedName)
 	})
 }
 
+// WithCancelledBackfillRequest seeds the fake client with a BackfillRequest named
+// "backfill1" targeting the MockStreamDefinition identified by n, with cancellation requested.
+func (b *FakeClientResourcesBuilder) WithCancelledBackfillRequest(n types.NamespacedName) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: n.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+				Cancel:      true,
+			},
+		})
+	})
+}
+
+// WithBackfillRequestRange seeds the fake client with a BackfillRequest named
+// "backfill1" targeting the MockStreamDefinition identified by n and bounded by the given time range.
+func (b *FakeClientResourcesBuilder) WithBackfillRequestRange(n types.NamespacedName, from time.Time, to time.Time) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: n.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+				From:        &metav1.Time{Time: from},
+				To:          &metav1.Time{Time: to},
+			},
+		})
+	})
+}
+
+// WithRetriedBackfillRequest seeds the fake client with a BackfillRequest named
+// "backfill1" targeting the MockStreamDefinition identified by n, with the given retry policy
+// and failedAttempts failed runs, the last one observed at lastFailure.
+func (b *FakeClientResourcesBuilder) WithRetriedBackfillRequest(n types.NamespacedName, policy *v1.BackfillRetryPolicy, failedAttempts int32, lastFailure time.Time) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		request := &v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: n.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+				RetryPolicy: policy,
+			},
+			Status: v1.BackfillRequestStatus{
+				Phase:          v1.BackfillRequestPhaseRunning,
+				FailedAttempts: failedAttempts,
+			},
+		}
+		if failedAttempts > 0 {
+			request.Status.LastFailureTime = &metav1.Time{Time: lastFailure}
+		}
+		client.WithObjects(request)
+	})
+}
+
+// WithCountedBackfillFailure seeds the fake client with a running BackfillRequest named "backfill1" targeting
+// the MockStreamDefinition identified by n, with the given retry policy and failedAttempts failed runs, the last
+// one being the failure of the job seeded by WithFailedJob.
+func (b *FakeClientResourcesBuilder) WithCountedBackfillFailure(n types.NamespacedName, policy *v1.BackfillRetryPolicy, failedAttempts int32) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: n.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+				RetryPolicy: policy,
+			},
+			Status: v1.BackfillRequestStatus{
+				Phase:            v1.BackfillRequestPhaseRunning,
+				FailedAttempts:   failedAttempts,
+				LastFailureTime:  &metav1.Time{Time: time.Now()},
+				LastFailedJobUID: FailedJobUID,
+			},
+		})
+	})
+}
+
+// WithTimeLimitedBackfillRequest seeds the fake client with a running BackfillRequest named
+// "backfill1" targeting the MockStreamDefinition identified by n, limited to the given maximum duration.
+func (b *FakeClientResourcesBuilder) WithTimeLimitedBackfillRequest(n types.NamespacedName, maxDuration time.Duration) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: n.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+				MaxDuration: &metav1.Duration{Duration: maxDuration},
+			},
+			Status: v1.BackfillRequestStatus{Phase: v1.BackfillRequestPhaseRunning},
+		})
+	})
+}
+
+// WithRunningBackfillJob seeds the fake client with a running backfill Job created at the given time.
+func (b *FakeClientResourcesBuilder) WithRunningBackfillJob(n types.NamespacedName, created time.Time) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         n.Namespace,
+				Name:              n.Name,
+				CreationTimestamp: metav1.Time{Time: created},
+				Labels:            map[string]string{"arcane/backfilling": "true"},
+				Annotations:       map[string]string{"configuration-hash": "old-hash"},
+			},
+		})
+	})
+}
+
+// WithApprovalRequiredNamespace seeds the fake client with the namespace of n labeled to require
+// approval of backfills.
+func (b *FakeClientResourcesBuilder) WithApprovalRequiredNamespace(n types.NamespacedName) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   n.Namespace,
+				Labels: map[string]string{v1.BackfillApprovalRequiredLabel: "true"},
+			},
+		})
+	})
+}
+
+// WithLabeledNamespace seeds the fake client with the namespace of n with the given labels.
+func (b *FakeClientResourcesBuilder) WithLabeledNamespace(n types.NamespacedName, labels map[string]string) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   n.Namespace,
+				Labels: labels,
+			},
+		})
+	})
+}
+
+// WithSecret seeds the fake client with a secret identified by n holding the given data.
+func (b *FakeClientResourcesBuilder) WithSecret(n types.NamespacedName, data map[string][]byte) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: n.Namespace,
+				Name:      n.Name,
+			},
+			Data: data,
+		})
+	})
+}
+
+// WithApprovedBackfillRequest seeds the fake client with a BackfillRequest named
+// "backfill1" targeting the MockStreamDefinition identified by n, approved by the given approver.
+func (b *FakeClientResourcesBuilder) WithApprovedBackfillRequest(n types.NamespacedName, approver string) *FakeClientResourcesBuilder {
+	return b.Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "backfill1",
+				Namespace:   n.Namespace,
+				Annotations: map[string]string{v1.BackfillApprovedByAnnotation: approver},
+			},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    n.Name,
+			},
+		})
+	})
+}
+
 // Build returns a single mutator function that applies all accumulated
 // resources to a *crfake.ClientBuilder. The result is computed on the first
 // call and the same function value is returned on subsequent calls.
@@ -5,6 +5,7 @@ import (
 
 	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -31,6 +32,12 @@ func NewMockStreamDefinitionBuilder(objectName types.NamespacedName) *MockStream
 				Source:      "sourceA",
 				Destination: "destinationB",
 				Suspended:   true,
+				JobTemplateRef: corev1.ObjectReference{
+					Name: "streaming-template",
+				},
+				BackfillJobTemplateRef: corev1.ObjectReference{
+					Name: "backfill-template",
+				},
 			},
 		},
 	}
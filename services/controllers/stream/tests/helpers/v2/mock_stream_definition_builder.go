@@ -85,6 +85,12 @@ func (b *MockStreamDefinitionBuilder) WithPhase(phase stream.Phase) *MockStreamD
 	return b
 }
 
+// WithConditions sets the status conditions of the stream definition.
+func (b *MockStreamDefinitionBuilder) WithConditions(conditions ...metav1.Condition) *MockStreamDefinitionBuilder {
+	b.definition.Status.Conditions = conditions
+	return b
+}
+
 // WithName sets the name and namespace of the stream definition.
 func (b *MockStreamDefinitionBuilder) WithName(n types.NamespacedName) *MockStreamDefinitionBuilder {
 	b.definition.Name = n.Name
@@ -159,6 +165,12 @@ func (b *MockStreamDefinitionBuilder) WithV2BackfillJobTemplateRef(name types.Na
 	return b
 }
 
+// WithSecretRef sets the secret referenced by the secretRef field of the stream definition.
+func (b *MockStreamDefinitionBuilder) WithSecretRef(name string) *MockStreamDefinitionBuilder {
+	b.definition.Spec.SecretRef = corev1.LocalObjectReference{Name: name}
+	return b
+}
+
 // Apply runs an arbitrary mutation function on the underlying definition.
 // This allows composing the builder with the existing functional-option style
 // helpers in this package.
@@ -300,3 +300,94 @@ func Test_StreamMetadataService_JobConfigurator_PreservesExistingEnvFrom(t *test
 	require.NotNil(t, job.Spec.Template.Spec.Containers[0].EnvFrom[1].SecretRef)
 	require.Equal(t, "my-secret", job.Spec.Template.Spec.Containers[0].EnvFrom[1].SecretRef.Name)
 }
+
+func Test_StreamMetadataService_JobConfigurator_StructuredSecretReferences(t *testing.T) {
+	// Arrange
+	streamClass := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-stream-class",
+		},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: "streaming.sneaksanddata.com",
+			APIVersion:  "v1",
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+			SecretRefs:  []string{"secretRef"},
+			SecretReferences: []v1.SecretReference{
+				{
+					FieldName:  "passwordRef",
+					Keys:       []v1.SecretKeyReference{{Key: "password", EnvName: "DB_PASSWORD"}},
+					Containers: []string{"main-container"},
+				},
+				{
+					FieldName: "settingsRef",
+					Kind:      v1.ReferencedObjectKindConfigMap,
+					MountPath: "/etc/settings",
+				},
+			},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := job_mock.NewMockSecretReferenceProvider(mockCtrl)
+	mockProvider.EXPECT().
+		GetReferenceForSecret("secretRef").
+		Return(&corev1.LocalObjectReference{Name: "databaseCredentials"}, nil).
+		Times(1)
+	mockProvider.EXPECT().
+		GetReferenceForSecret("passwordRef").
+		Return(&corev1.LocalObjectReference{Name: "databasePassword"}, nil).
+		Times(1)
+	mockProvider.EXPECT().
+		GetReferenceForSecret("settingsRef").
+		Return(&corev1.LocalObjectReference{Name: "settings"}, nil).
+		Times(1)
+	service := stream.NewStreamMetadataService(streamClass, mockProvider)
+
+	// Act
+	configurator, err := service.JobConfigurator()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, configurator)
+
+	// Apply configurator to a job with multiple containers
+	job := &batchv1.Job{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "main-container",
+							Image: "main-image:latest",
+						},
+						{
+							Name:  "sidecar-container",
+							Image: "sidecar-image:latest",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err = configurator.ConfigureJob(job)
+	require.NoError(t, err)
+
+	// The legacy secret reference is still injected into all containers
+	require.Len(t, job.Spec.Template.Spec.Containers[0].EnvFrom, 1)
+	require.Len(t, job.Spec.Template.Spec.Containers[1].EnvFrom, 1)
+
+	// The selected key is injected only into the targeted container
+	require.Len(t, job.Spec.Template.Spec.Containers[0].Env, 1)
+	require.Equal(t, "DB_PASSWORD", job.Spec.Template.Spec.Containers[0].Env[0].Name)
+	require.Equal(t, "databasePassword", job.Spec.Template.Spec.Containers[0].Env[0].ValueFrom.SecretKeyRef.Name)
+	require.Empty(t, job.Spec.Template.Spec.Containers[1].Env)
+
+	// The config map is mounted into all containers
+	require.Len(t, job.Spec.Template.Spec.Volumes, 1)
+	require.Equal(t, "settings", job.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	require.Len(t, job.Spec.Template.Spec.Containers[0].VolumeMounts, 1)
+	require.Len(t, job.Spec.Template.Spec.Containers[1].VolumeMounts, 1)
+}
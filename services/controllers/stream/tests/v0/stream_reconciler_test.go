@@ -123,7 +123,7 @@ func Test_UpdatePhase_Pending_To_Running_not_recreate_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV1)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -453,9 +453,9 @@ func Test_UpdatePhase_Backfilling_Job_Failed(t *testing.T) {
 	require.Equal(t, result, reconcile.Result{})
 
 	// Assert
-	assertStreamDefinitionPhase(t, k8sClient, objectName, stream.Failed)
+	assertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
 	assertJobNotExists(t, k8sClient, objectName)
-	assertBackfillRequestNotCompleted(t, k8sClient)
+	assertBackfillRequestCompleted(t, k8sClient)
 }
 
 func Test_UpdatePhase_Backfilling_To_Running(t *testing.T) {
@@ -570,7 +570,7 @@ func createReconciler(k8sClient client.Client, mockJob *batchv1.Job, mockCtrl *g
 		stream.BatchJob: job.NewJobBackend(k8sClient, jobBuilder, recorder, statusManager),
 		stream.CronJob:  cron_job.NewCronJobBackend(k8sClient, jobBuilder, recorder, statusManager),
 	}
-	return stream.NewStreamReconciler(k8sClient, gvk, jobBuilder, &sc, recorder, contracts.FromUnstructured, backendResourceManagers, backfillBackendResourceManager)
+	return stream.NewStreamReconciler(k8sClient, gvk, jobBuilder, &sc, recorder, contracts.FromUnstructured, backendResourceManagers, backfillBackendResourceManager, stream.ControllerConfig{}, nil)
 }
 
 func assertStreamDefinitionPhase(t *testing.T, k8sClient client.Client, name types.NamespacedName, phase stream.Phase) {
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/tests/helpers"
+	v3 "github.com/SneaksAndData/arcane-operator/services/controllers/stream/tests/helpers/v2"
+	"github.com/SneaksAndData/arcane-operator/services/job"
+	"github.com/SneaksAndData/arcane-operator/tests/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test_Partitioned_Backfill runs a partitioned backfill request through the stream reconciler: the Indexed Job is
+// created, reports progress, fails on one index and is retried, and the retried job completes the request.
+func Test_Partitioned_Backfill(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	resources := helpers.NewFakeClientResourcesBuilder().Apply(func(client *crfake.ClientBuilder) {
+		client.WithObjects(&v1.BackfillRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "backfill1", Namespace: objectName.Namespace},
+			Spec: v1.BackfillRequestSpec{
+				StreamClass: "MockStreamDefinition",
+				StreamId:    objectName.Name,
+				Partitions:  3,
+				RetryPolicy: &v1.BackfillRetryPolicy{MaxAttempts: 2, InitialBackoff: &metav1.Duration{}},
+			},
+		})
+	})
+
+	// The deletion of the failed job fails once, so the failure is handled by two reconciliations
+	failDelete := false
+	k8sClient := interceptor.NewClient(helpers.SetupClientFromBuilders(nil, builder, resources).(client.WithWatch), interceptor.Funcs{
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if _, ok := obj.(*batchv1.Job); ok && failDelete {
+				failDelete = false
+				return errors.New("connection reset")
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	attempt := 0
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.NamespacedName, configurator job.Configurator) (*batchv1.Job, error) {
+			attempt++
+			newJob := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      objectName.Name,
+					Namespace: objectName.Namespace,
+					UID:       types.UID(fmt.Sprintf("backfill-job-%d", attempt)),
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "backfill"}}}},
+				},
+			}
+			return newJob, configurator.ConfigureJob(newJob)
+		}).Times(2)
+	reconciler, recorder := createReconciler(k8sClient, jobBuilder)
+
+	// Act: the Indexed Job is created
+	reconcileStream(t, reconciler)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+	backfillJob := getJob(t, k8sClient)
+	require.Equal(t, batchv1.IndexedCompletion, *backfillJob.Spec.CompletionMode)
+	require.Equal(t, int32(3), *backfillJob.Spec.Completions)
+	require.Equal(t, int32(3), *backfillJob.Spec.Parallelism)
+	require.Contains(t, backfillJob.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "STREAMCONTEXT__PARTITION_COUNT", Value: "3"})
+
+	// Act: the job reports its progress
+	backfillJob.Annotations["arcane/backfill-progress"] = `{"percent":40,"rows":1000}`
+	require.NoError(t, k8sClient.Update(t.Context(), backfillJob))
+	reconcileStream(t, reconciler)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, &v1.BackfillProgress{Percent: 40, Rows: 1000})
+	helpers.AssertStreamDefinitionConditionMessage(t, k8sClient, objectName, "progress: 40%")
+
+	// Act: one index fails, which fails the whole job, and the job cannot be deleted at the first attempt
+	backfillJob = getJob(t, k8sClient)
+	backfillJob.Status = batchv1.JobStatus{
+		Succeeded:     2,
+		Failed:        1,
+		FailedIndexes: new("1"),
+		Conditions:    []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+	}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), backfillJob))
+	failDelete = true
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.Error(t, err)
+	reconcileStream(t, reconciler)
+
+	// Assert: the failure is counted once and the progress of the failed job is cleared
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, nil)
+	helpers.AssertEventRecorded(t, recorder, objectName, nil)
+
+	// Act: the job is retried and all indexes complete
+	reconcileStream(t, reconciler)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	backfillJob = getJob(t, k8sClient)
+	require.Equal(t, types.UID("backfill-job-2"), backfillJob.UID)
+	require.Equal(t, batchv1.IndexedCompletion, *backfillJob.Spec.CompletionMode)
+	backfillJob.Status = batchv1.JobStatus{
+		Succeeded:  3,
+		Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+	}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), backfillJob))
+	reconcileStream(t, reconciler)
+
+	// Assert
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseSucceeded)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+}
+
+func reconcileStream(t *testing.T, reconciler reconcile.Reconciler) {
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+}
+
+func getJob(t *testing.T, k8sClient client.Client) *batchv1.Job {
+	backfillJob := &batchv1.Job{}
+	require.NoError(t, k8sClient.Get(t.Context(), objectName, backfillJob))
+	return backfillJob
+}
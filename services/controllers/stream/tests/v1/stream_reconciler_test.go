@@ -1,10 +1,13 @@
 package v1
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv2 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v2"
 	v2 "github.com/SneaksAndData/arcane-operator/pkg/test/generated/applyconfiguration/streaming/v2"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
@@ -13,6 +16,7 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend/job"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/tests/helpers"
 	v3 "github.com/SneaksAndData/arcane-operator/services/controllers/stream/tests/helpers/v2"
+	jobconfig "github.com/SneaksAndData/arcane-operator/services/job"
 	"github.com/SneaksAndData/arcane-operator/tests/mocks"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -29,6 +33,7 @@ var objectName = types.NamespacedName{Name: "stream1", Namespace: "default"}
 var streamingJobTemplateName = types.NamespacedName{Name: "streaming-template", Namespace: "default"}
 var backfillJobTemplateName = types.NamespacedName{Name: "backfill-template", Namespace: "default"}
 var batchJobTemplateName = types.NamespacedName{Name: "batch-template", Namespace: "default"}
+var secretName = types.NamespacedName{Name: "db-credentials", Namespace: "default"}
 
 func Test_UpdatePhase_New_To_Suspended(t *testing.T) {
 	// Arrange
@@ -61,6 +66,29 @@ func Test_UpdatePhase_New_To_Pending(t *testing.T) {
 	helpers.AssertJobNotExists(t, k8sClient, objectName)
 }
 
+func Test_UpdatePhase_New_To_Pending_initial_backfill_approved_by_operator(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertBackfillRequests(t, k8sClient, func(bfrList *v1.BackfillRequestList, err error) {
+		require.NoError(t, err)
+		require.Len(t, bfrList.Items, 1)
+		require.Empty(t, bfrList.Items[0].Annotations[v1.BackfillApprovedByAnnotation])
+		require.Equal(t, v1.OperatorApprover, bfrList.Items[0].Status.ApprovedBy)
+		require.NotNil(t, bfrList.Items[0].Status.ApprovalTime)
+	})
+}
+
 func Test_UpdatePhase_New_To_Pending_with_schedule(t *testing.T) {
 	// Arrange
 	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithSuspendedSpec(false).WithSchedule("* * * * *")
@@ -175,7 +203,7 @@ func Test_UpdatePhase_Pending_To_Running_not_recreate_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -197,6 +225,398 @@ func Test_UpdatePhase_Pending_To_Running_not_recreate_job(t *testing.T) {
 	helpers.AssertJobConfiguration(t, k8sClient, objectName, definitionHash)
 }
 
+func Test_UpdatePhase_Running_recreate_job_on_pod_policy_change(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, nil)
+	jobHash, _ := referencedSecretsHashes(t, k8sClient)
+
+	resources := helpers.NewFakeClientResourcesBuilder().WithConsistentJob(objectName, jobHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.NamespacedName, configurator jobconfig.Configurator) (*batchv1.Job, error) {
+			newJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: objectName.Namespace, Name: objectName.Name}}
+			return newJob, configurator.ConfigureJob(newJob)
+		}).Times(1)
+	policy := &v1.PodPolicy{NodeSelector: map[string]string{"pool": "streaming"}}
+	reconciler, _ := createReconciler(k8sClient, jobBuilder, func(sc *v1.StreamClass) {
+		sc.Spec.PodPolicy = policy
+	})
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	policyHash, err := policy.Hash()
+	require.NoError(t, err)
+	createdJob := &batchv1.Job{}
+	require.NoError(t, k8sClient.Get(t.Context(), objectName, createdJob))
+	require.Equal(t, policyHash, createdJob.Annotations[jobconfig.PodPolicyHashAnnotation])
+	require.Equal(t, "streaming", createdJob.Spec.Template.Spec.NodeSelector["pool"])
+}
+
+func Test_UpdatePhase_Pending_To_Running_job_records_referenced_secrets_hash(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	secret := helpers.NewFakeClientResourcesBuilder().WithSecret(secretName, map[string][]byte{"password": []byte("old")})
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, secret)
+	jobHash, secretsHash := referencedSecretsHashes(t, k8sClient, referenceSecret)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.NamespacedName, configurator jobconfig.Configurator) (*batchv1.Job, error) {
+			newJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: objectName.Namespace, Name: objectName.Name}}
+			return newJob, configurator.ConfigureJob(newJob)
+		}).Times(1)
+	reconciler, _ := createReconciler(k8sClient, jobBuilder, referenceSecret)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert: the secrets are not part of the configuration
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, jobHash)
+	createdJob := &batchv1.Job{}
+	require.NoError(t, k8sClient.Get(t.Context(), objectName, createdJob))
+	require.NotEmpty(t, secretsHash)
+	require.Equal(t, secretsHash, createdJob.Annotations[jobconfig.ReferencedSecretsHashAnnotation])
+}
+
+func Test_UpdatePhase_Running_recreate_job_on_secret_rotation(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	secret := helpers.NewFakeClientResourcesBuilder().WithSecret(secretName, map[string][]byte{"password": []byte("old")})
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, secret)
+	jobHash, secretsHash := referencedSecretsHashes(t, k8sClient, referenceSecret)
+
+	resources := helpers.NewFakeClientResourcesBuilder().
+		WithSecret(secretName, map[string][]byte{"password": []byte("rotated")}).
+		WithConsistentJobReferencingSecrets(objectName, jobHash, secretsHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   objectName.Namespace,
+			Name:        objectName.Name,
+			Annotations: map[string]string{"configuration-hash": "new-hash"},
+		},
+	}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).Times(1)
+	reconciler, _ := createReconciler(k8sClient, jobBuilder, referenceSecret)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, "new-hash")
+}
+
+func Test_UpdatePhase_Running_not_recreate_job_with_unchanged_secret(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	secret := helpers.NewFakeClientResourcesBuilder().WithSecret(secretName, map[string][]byte{"password": []byte("old")})
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, secret)
+	jobHash, secretsHash := referencedSecretsHashes(t, k8sClient, referenceSecret)
+
+	resources := helpers.NewFakeClientResourcesBuilder().
+		WithSecret(secretName, map[string][]byte{"password": []byte("old")}).
+		WithConsistentJobReferencingSecrets(objectName, jobHash, secretsHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+	reconciler, _ := createReconciler(k8sClient, nil, referenceSecret)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, jobHash)
+}
+
+func Test_UpdatePhase_Running_not_recreate_job_without_referenced_secrets_hash(t *testing.T) {
+	// Arrange: the job was created before the hash of the referenced secrets was recorded on the jobs
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, nil)
+	jobHash, _ := referencedSecretsHashes(t, k8sClient, referenceSecret)
+
+	resources := helpers.NewFakeClientResourcesBuilder().
+		WithSecret(secretName, map[string][]byte{"password": []byte("rotated")}).
+		WithConsistentJob(objectName, jobHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+	reconciler, _ := createReconciler(k8sClient, nil, referenceSecret)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, jobHash)
+}
+
+func Test_UpdatePhase_Running_not_recreate_job_on_ignored_secret_rotation(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	secret := helpers.NewFakeClientResourcesBuilder().WithSecret(secretName, map[string][]byte{"password": []byte("old")})
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, secret)
+	jobHash, secretsHash := referencedSecretsHashes(t, k8sClient, referenceSecret)
+
+	resources := helpers.NewFakeClientResourcesBuilder().
+		WithSecret(secretName, map[string][]byte{"password": []byte("rotated")}).
+		WithConsistentJobReferencingSecrets(objectName, jobHash, secretsHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+	reconciler, _ := createReconciler(k8sClient, nil, referenceSecret, ignoreSecretRotation)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, jobHash)
+}
+
+func Test_UpdatePhase_Pending_template_not_found(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(types.NamespacedName{Name: "missing-template", Namespace: "default"})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.TemplateNotFoundReason)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "Warning TemplateNotFound")
+	})
+}
+
+func Test_UpdatePhase_Pending_secret_not_found(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, _ := createReconciler(k8sClient, nil, referenceSecret)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.SecretNotFoundReason)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_config_map_not_found(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithSecretRef(secretName.Name)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, _ := createReconciler(k8sClient, nil, func(sc *v1.StreamClass) {
+		sc.Spec.SecretReferences = []v1.SecretReference{{FieldName: "secretRef", Kind: v1.ReferencedObjectKindConfigMap}}
+	})
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.ConfigMapNotFoundReason)
+	helpers.AssertStreamDefinitionConditionMessage(t, k8sClient, objectName, "config map default/"+secretName.Name)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_preflight_failure_keeps_other_conditions(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(types.NamespacedName{Name: "missing-template", Namespace: "default"}).
+		WithConditions(metav1.Condition{
+			Type:               "SourceReachable",
+			Status:             metav1.ConditionTrue,
+			Reason:             "Connected",
+			Message:            "Reported by the stream plugin",
+			LastTransitionTime: metav1.Now(),
+		})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.TemplateNotFoundReason)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, "Connected")
+}
+
+func Test_UpdatePhase_Pending_service_account_not_found(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, _ := createReconciler(k8sClient, nil, func(sc *v1.StreamClass) {
+		sc.Spec.PodPolicy = &v1.PodPolicy{ServiceAccountName: "stream-runner"}
+	})
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.ServiceAccountNotFoundReason)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Running_template_not_found_keeps_outdated_job(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(types.NamespacedName{Name: "missing-template", Namespace: "default"})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithOutdatedJob(objectName))
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: 10 * time.Second}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.TemplateNotFoundReason)
+	helpers.AssertJobConfiguration(t, k8sClient, objectName, "old-hash")
+}
+
+func Test_UpdatePhase_Pending_template_not_found_with_backoff(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(types.NamespacedName{Name: "missing-template", Namespace: "default"}).
+		Apply(func(definition *testv2.MockStreamDefinition) {
+			definition.Status.Conditions = []metav1.Condition{{
+				Type:               "Error",
+				Status:             metav1.ConditionTrue,
+				Reason:             stream.TemplateNotFoundReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			}}
+		})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+
+	// Assert
+	require.GreaterOrEqual(t, result.RequeueAfter, time.Minute)
+	require.Less(t, result.RequeueAfter, 5*time.Minute)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, stream.TemplateNotFoundReason)
+}
+
+func Test_UpdatePhase_Running_clears_preflight_condition(t *testing.T) {
+	// Arrange
+	streamDefinitionBuilder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Running).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		Apply(func(definition *testv2.MockStreamDefinition) {
+			definition.Status.Conditions = []metav1.Condition{{
+				Type:               "Error",
+				Status:             metav1.ConditionTrue,
+				Reason:             stream.TemplateNotFoundReason,
+				LastTransitionTime: metav1.Now(),
+			}}
+		})
+	k8sClient := helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, nil)
+	jobHash, _ := referencedSecretsHashes(t, k8sClient)
+
+	resources := helpers.NewFakeClientResourcesBuilder().WithConsistentJob(objectName, jobHash)
+	k8sClient = helpers.SetupClientFromBuilders(nil, streamDefinitionBuilder, resources)
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{}, result)
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertStreamDefinitionNoCondition(t, k8sClient, objectName, stream.TemplateNotFoundReason)
+	helpers.AssertStreamDefinitionCondition(t, k8sClient, objectName, "StreamRunning")
+}
+
 func Test_UpdatePhase_Pending_To_Scheduled_no_job(t *testing.T) {
 	// Arrange
 	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
@@ -249,6 +669,146 @@ func Test_UpdatePhase_Pending_To_Backfilling_no_job(t *testing.T) {
 	// Assert
 	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
 	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+}
+
+func Test_UpdatePhase_Pending_To_Running_with_bfr_awaiting_approval(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName).WithBackfillRequest(objectName))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, recorder := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseAwaitingApproval)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillAwaitingApproval")
+	})
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_with_approved_bfr(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName).WithApprovedBackfillRequest(objectName, "data-owner"))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+	helpers.AssertBackfillRequestApprovedBy(t, k8sClient, objectName, "data-owner")
+}
+
+func Test_UpdatePhase_Pending_To_Running_with_unverified_approval(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName).WithApprovedBackfillRequest(objectName, "data-owner"))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, recorder := createReconcilerWithConfig(k8sClient, jobBuilder, stream.ControllerConfig{ApprovalsVerified: false})
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseAwaitingApproval)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillApprovalUnverified")
+	})
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_with_bounded_range(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequestRange(objectName, from, from.Add(24*time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_with_invalid_range(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequestRange(objectName, from, from.Add(-24*time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Times(0)
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedWithReason(t, k8sClient, objectName, v1.BackfillInvalidRangeReason)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
 }
 
 func Test_UpdatePhase_Pending_To_Backfilling_recreate_job(t *testing.T) {
@@ -301,10 +861,99 @@ func Test_UpdatePhase_Running_To_Suspended_no_job(t *testing.T) {
 
 func Test_UpdatePhase_Running_To_Suspended_stop_job(t *testing.T) {
 	// Arrange
-	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Running).WithSuspendedSpec(true)
-	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequest(objectName).WithOutdatedJob(objectName))
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Running).WithSuspendedSpec(true)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequest(objectName).WithOutdatedJob(objectName))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Suspended)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Running_To_Suspended_class_suspended(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Running).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithOutdatedJob(objectName))
+
+	reconciler, _ := createReconciler(k8sClient, nil, suspendClass)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Suspended)
+	helpers.AssertStreamDefinitionSuspendedSpec(t, k8sClient, objectName, false)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Suspended_To_Suspended_class_suspended(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Suspended).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
+
+	reconciler, _ := createReconciler(k8sClient, nil, suspendClass)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Suspended)
+	helpers.AssertStreamDefinitionSuspendedSpec(t, k8sClient, objectName, false)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Suspended_To_Pending_namespace_in_scope(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Suspended).WithSuspendedSpec(false)
+	resources := helpers.NewFakeClientResourcesBuilder().WithLabeledNamespace(objectName, map[string]string{"tenant": "a"})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, resources)
+
+	reconciler, _ := createReconciler(k8sClient, nil, selectNamespaces("a"))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+}
+
+func Test_UpdatePhase_Suspended_namespace_out_of_scope(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Suspended).WithSuspendedSpec(false)
+	resources := helpers.NewFakeClientResourcesBuilder().WithLabeledNamespace(objectName, map[string]string{"tenant": "b"})
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, resources)
+
+	reconciler, _ := createReconciler(k8sClient, nil, selectNamespaces("a"))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Suspended)
+}
+
+func Test_UpdatePhase_Suspended_stream_out_of_scope(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Suspended).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, nil)
 
-	reconciler, _ := createReconciler(k8sClient, nil)
+	reconciler, _ := createReconciler(k8sClient, nil, func(sc *v1.StreamClass) {
+		sc.Spec.StreamSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+	})
 
 	// Act
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
@@ -313,7 +962,6 @@ func Test_UpdatePhase_Running_To_Suspended_stop_job(t *testing.T) {
 
 	// Assert
 	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Suspended)
-	helpers.AssertJobNotExists(t, k8sClient, objectName)
 }
 
 func Test_UpdatePhase_Running_To_Suspended_to_Pending(t *testing.T) {
@@ -464,6 +1112,7 @@ func Test_UpdatePhase_Backfilling_To_Pending_with_job_completed(t *testing.T) {
 	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
 	helpers.AssertJobNotExists(t, k8sClient, objectName)
 	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseSucceeded)
 }
 
 func Test_UpdatePhase_Backfilling_To_Pending_with_deleted_bfr(t *testing.T) {
@@ -503,6 +1152,134 @@ func Test_UpdatePhase_Backfilling_To_Backfilling_with_job_running(t *testing.T)
 	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
 }
 
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_job_progress(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	progress := `{"percent":42,"rows":1000,"watermark":"2026-01-01T00:00:00Z","updateTime":"2026-01-02T00:00:00Z"}`
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, progress).WithBackfillRequest(objectName))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, &v1.BackfillProgress{Percent: 42, Rows: 1000, Watermark: "2026-01-01T00:00:00Z"})
+	helpers.AssertStreamDefinitionConditionMessage(t, k8sClient, objectName, "progress: 42%")
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_invalid_job_progress(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, "42%").WithBackfillRequest(objectName))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, nil)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillProgressInvalid")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_out_of_range_job_progress(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	progress := `{"percent":142,"rows":1000}`
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, progress).WithBackfillRequest(objectName))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, nil)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillProgressInvalid")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_unchanged_invalid_job_progress(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, "42%").WithBackfillRequest(objectName))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	helpers.AssertEventRecorded(t, recorder, objectName, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	for len(recorder.Events) > 0 {
+		require.NotContains(t, <-recorder.Events, "BackfillProgressInvalid")
+	}
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_before_deadline(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().
+		WithRunningBackfillJob(objectName, time.Now().Add(-30*time.Minute)).
+		WithTimeLimitedBackfillRequest(objectName, time.Hour))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Greater(t, result.RequeueAfter, 29*time.Minute)
+	require.LessOrEqual(t, result.RequeueAfter, 30*time.Minute)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Backfilling_To_Pending_with_deadline_exceeded(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().
+		WithRunningBackfillJob(objectName, time.Now().Add(-2*time.Hour)).
+		WithTimeLimitedBackfillRequest(objectName, time.Hour))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestFailedWithReason(t, k8sClient, objectName, v1.BackfillDeadlineExceededReason)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillDeadlineExceeded")
+	})
+}
+
 func Test_UpdatePhase_Backfilling_To_Backfilling_with_no_job(t *testing.T) {
 	// Arrange
 	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
@@ -635,9 +1412,169 @@ func Test_UpdatePhase_Backfilling_Job_Failed(t *testing.T) {
 	require.Equal(t, result, reconcile.Result{})
 
 	// Assert
-	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Failed)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+}
+
+func Test_UpdatePhase_Backfilling_Job_Failed_with_retry(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 0, time.Time{}).WithFailedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillRetrying")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_Job_Failed_counted_once(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithCountedBackfillFailure(objectName, policy, 1).WithFailedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
 	helpers.AssertJobNotExists(t, k8sClient, objectName)
 	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "attempt 1 of 3")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_Job_Failed_retries_exhausted(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 2, time.Now().Add(-time.Hour)).WithFailedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 3)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillFailed")
+	})
+}
+
+func Test_UpdatePhase_Pending_waits_for_retry_backoff(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3, InitialBackoff: &metav1.Duration{Duration: time.Hour}}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 1, time.Now()))
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Greater(t, result.RequeueAfter, time.Duration(0))
+	require.LessOrEqual(t, result.RequeueAfter, time.Hour)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_after_retry_backoff(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Pending).WithV1BackfillJobTemplateRef(batchJobTemplateName)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3, InitialBackoff: &metav1.Duration{Duration: time.Minute}}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 1, time.Now().Add(-time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Backfilling_To_Pending_with_cancelled_bfr(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithCancelledBackfillRequest(objectName).WithOutdatedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseCancelled)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillCancelled")
+	})
+}
+
+func Test_UpdatePhase_Pending_To_Running_with_cancelled_bfr(t *testing.T) {
+	// Arrange
+	builder := v3.NewMockStreamDefinitionLayoutV1Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithCancelledBackfillRequest(objectName))
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseCancelled)
 }
 
 func Test_UpdatePhase_Backfilling_To_Running(t *testing.T) {
@@ -742,7 +1679,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_no_cron_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -781,7 +1718,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_recreate_cron_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -826,7 +1763,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_not_recreate_cron_job(t *testing.T)
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -902,7 +1839,11 @@ func Test_UpdatePhase_Scheduled_to_Backfilling(t *testing.T) {
 	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
 }
 
-func createReconciler(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder) (reconcile.Reconciler, *record.FakeRecorder) {
+func createReconciler(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder, configureClass ...func(*v1.StreamClass)) (reconcile.Reconciler, *record.FakeRecorder) {
+	return createReconcilerWithConfig(k8sClient, jobBuilder, stream.ControllerConfig{ApprovalsVerified: true}, configureClass...)
+}
+
+func createReconcilerWithConfig(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder, config stream.ControllerConfig, configureClass ...func(*v1.StreamClass)) (reconcile.Reconciler, *record.FakeRecorder) {
 	recorder := record.NewFakeRecorder(10)
 	gvk := schema.GroupVersionKind{Group: "streaming.sneaksanddata.com", Version: "v1", Kind: "MockStreamDefinition"}
 	mock := v2.MockStreamDefinition("name", "namespace")
@@ -915,6 +1856,13 @@ func createReconciler(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder)
 			PluralName:  "mockstreamdefinitions",
 		},
 	}
+	for _, configure := range configureClass {
+		configure(&sc)
+	}
+	scope, err := stream.NewStreamClassScope(k8sClient, &sc)
+	if err != nil {
+		panic(err)
+	}
 	statusManager := stream.NewDefaultStatusManager(k8sClient, gvk, &sc, contracts.FromUnstructured)
 	backfillBackendResourceManager := job.NewBackfillBackendResourceManager(&sc, k8sClient, statusManager, recorder)
 	backendResourceManagers := map[stream.Backend]stream.BackendResourceManager{
@@ -929,6 +1877,48 @@ func createReconciler(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder)
 		recorder,
 		contracts.FromUnstructured,
 		backendResourceManagers,
-		backfillBackendResourceManager)
+		backfillBackendResourceManager,
+		config,
+		scope)
 	return reconciler, recorder
 }
+
+func suspendClass(sc *v1.StreamClass) {
+	sc.Spec.Suspend = true
+}
+
+func selectNamespaces(tenant string) func(*v1.StreamClass) {
+	return func(sc *v1.StreamClass) {
+		sc.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": tenant}}
+	}
+}
+
+func referenceSecret(sc *v1.StreamClass) {
+	sc.Spec.SecretRefs = []string{"secretRef"}
+}
+
+func ignoreSecretRotation(sc *v1.StreamClass) {
+	sc.Spec.IgnoreSecretRotation = true
+}
+
+// referencedSecretsHashes returns the configuration of the stream and the hash of the secrets currently stored in the
+// client, as computed by the stream controller of a class configured with the given options.
+func referencedSecretsHashes(t *testing.T, k8sClient client.Client, configureClass ...func(*v1.StreamClass)) (string, string) {
+	sc := v1.StreamClass{}
+	for _, configure := range configureClass {
+		configure(&sc)
+	}
+
+	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
+	require.NoError(t, err)
+
+	def, err := contracts.FromUnstructured(u, nil)
+	require.NoError(t, err)
+
+	secretsHash, err := stream.ReferencedSecretsHash(t.Context(), k8sClient, &sc, def)
+	require.NoError(t, err)
+
+	configuration, err := def.CurrentConfiguration(nil)
+	require.NoError(t, err)
+	return configuration, secretsHash
+}
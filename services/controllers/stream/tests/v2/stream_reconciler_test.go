@@ -3,6 +3,7 @@ package v2
 import (
 	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	v2 "github.com/SneaksAndData/arcane-operator/pkg/test/generated/applyconfiguration/streaming/v2"
@@ -175,7 +176,7 @@ func Test_UpdatePhase_Pending_To_Running_not_recreate_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -249,6 +250,116 @@ func Test_UpdatePhase_Pending_To_Backfilling_no_job(t *testing.T) {
 	// Assert
 	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
 	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+}
+
+func Test_UpdatePhase_Pending_To_Running_with_bfr_awaiting_approval(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName).
+		WithV2BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName).WithBackfillRequest(objectName))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, recorder := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseAwaitingApproval)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillAwaitingApproval")
+	})
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_with_approved_bfr(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Pending).WithV2BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithApprovalRequiredNamespace(objectName).WithApprovedBackfillRequest(objectName, "data-owner"))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+	helpers.AssertBackfillRequestApprovedBy(t, k8sClient, objectName, "data-owner")
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_with_bounded_range(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Pending).WithV2BackfillJobTemplateRef(batchJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequestRange(objectName, from, from.Add(24*time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_with_invalid_range(t *testing.T) {
+	// Arrange
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Pending).WithV2BackfillJobTemplateRef(batchJobTemplateName).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithBackfillRequestRange(objectName, from, from.Add(-24*time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Times(0)
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedWithReason(t, k8sClient, objectName, v1.BackfillInvalidRangeReason)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
 }
 
 func Test_UpdatePhase_Pending_To_Backfilling_recreate_job(t *testing.T) {
@@ -464,6 +575,7 @@ func Test_UpdatePhase_Backfilling_To_Pending_with_job_completed(t *testing.T) {
 	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
 	helpers.AssertJobNotExists(t, k8sClient, objectName)
 	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseSucceeded)
 }
 
 func Test_UpdatePhase_Backfilling_To_Pending_with_deleted_bfr(t *testing.T) {
@@ -503,6 +615,90 @@ func Test_UpdatePhase_Backfilling_To_Backfilling_with_job_running(t *testing.T)
 	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
 }
 
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_job_progress(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	progress := `{"percent":42,"rows":1000,"watermark":"2026-01-01T00:00:00Z","updateTime":"2026-01-02T00:00:00Z"}`
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, progress).WithBackfillRequest(objectName))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, &v1.BackfillProgress{Percent: 42, Rows: 1000, Watermark: "2026-01-01T00:00:00Z"})
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_with_invalid_job_progress(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithProgressReportingJob(objectName, "42%").WithBackfillRequest(objectName))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertBackfillRequestProgress(t, k8sClient, objectName, nil)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillProgressInvalid")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_To_Backfilling_before_deadline(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().
+		WithRunningBackfillJob(objectName, time.Now().Add(-30*time.Minute)).
+		WithTimeLimitedBackfillRequest(objectName, time.Hour))
+
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Greater(t, result.RequeueAfter, 29*time.Minute)
+	require.LessOrEqual(t, result.RequeueAfter, 30*time.Minute)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Backfilling_To_Pending_with_deadline_exceeded(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().
+		WithRunningBackfillJob(objectName, time.Now().Add(-2*time.Hour)).
+		WithTimeLimitedBackfillRequest(objectName, time.Hour))
+
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestFailedWithReason(t, k8sClient, objectName, v1.BackfillDeadlineExceededReason)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillDeadlineExceeded")
+	})
+}
+
 func Test_UpdatePhase_Backfilling_To_Backfilling_with_no_job(t *testing.T) {
 	// Arrange
 	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).
@@ -635,9 +831,147 @@ func Test_UpdatePhase_Backfilling_Job_Failed(t *testing.T) {
 	require.Equal(t, result, reconcile.Result{})
 
 	// Assert
-	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Failed)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+}
+
+func Test_UpdatePhase_Backfilling_Job_Failed_with_retry(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 0, time.Time{}).WithFailedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
 	helpers.AssertJobNotExists(t, k8sClient, objectName)
 	helpers.AssertBackfillRequestNotCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseRunning)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 1)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillRetrying")
+	})
+}
+
+func Test_UpdatePhase_Backfilling_Job_Failed_retries_exhausted(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 2, time.Now().Add(-time.Hour)).WithFailedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseFailed)
+	helpers.AssertBackfillRequestFailedAttempts(t, k8sClient, objectName, 3)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillFailed")
+	})
+}
+
+func Test_UpdatePhase_Pending_waits_for_retry_backoff(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Pending).WithV2BackfillJobTemplateRef(batchJobTemplateName)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3, InitialBackoff: &metav1.Duration{Duration: time.Hour}}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 1, time.Now()))
+	reconciler, _ := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Greater(t, result.RequeueAfter, time.Duration(0))
+	require.LessOrEqual(t, result.RequeueAfter, time.Hour)
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Pending_To_Backfilling_after_retry_backoff(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Pending).WithV2BackfillJobTemplateRef(batchJobTemplateName)
+	policy := &v1.BackfillRetryPolicy{MaxAttempts: 3, InitialBackoff: &metav1.Duration{Duration: time.Minute}}
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithRetriedBackfillRequest(objectName, policy, 1, time.Now().Add(-time.Hour)))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(batchJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Backfilling)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+}
+
+func Test_UpdatePhase_Backfilling_To_Pending_with_cancelled_bfr(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).WithPhase(stream.Backfilling).WithSuspendedSpec(false)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithCancelledBackfillRequest(objectName).WithOutdatedJob(objectName))
+	reconciler, recorder := createReconciler(k8sClient, nil)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Pending)
+	helpers.AssertJobNotExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseCancelled)
+	helpers.AssertEventRecorded(t, recorder, objectName, func(t *testing.T, event string) {
+		require.Contains(t, event, "BackfillCancelled")
+	})
+}
+
+func Test_UpdatePhase_Pending_To_Running_with_cancelled_bfr(t *testing.T) {
+	// Arrange
+	builder := helpersv2.NewMockStreamDefinitionLayoutV2Builder(objectName).
+		WithSuspendedSpec(false).
+		WithPhase(stream.Pending).
+		WithStreamingJobTemplateRef(streamingJobTemplateName)
+	k8sClient := helpers.SetupClientFromBuilders(nil, builder, helpers.NewFakeClientResourcesBuilder().WithCancelledBackfillRequest(objectName))
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockJob := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: objectName.Name, Namespace: objectName.Namespace}}
+	jobBuilder := mocks.NewMockJobBuilder(mockCtrl)
+	jobBuilder.EXPECT().BuildJob(gomock.Any(), gomock.Eq(streamingJobTemplateName), gomock.Any()).Return(&mockJob, nil).AnyTimes()
+	reconciler, _ := createReconciler(k8sClient, jobBuilder)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: objectName})
+	require.NoError(t, err)
+	require.Equal(t, result, reconcile.Result{})
+
+	// Assert
+	helpers.AssertStreamDefinitionPhase(t, k8sClient, objectName, stream.Running)
+	helpers.AssertJobExists(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestCompleted(t, k8sClient, objectName)
+	helpers.AssertBackfillRequestPhase(t, k8sClient, objectName, v1.BackfillRequestPhaseCancelled)
 }
 
 func Test_UpdatePhase_Backfilling_To_Running(t *testing.T) {
@@ -742,7 +1076,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_no_cron_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -781,7 +1115,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_recreate_cron_job(t *testing.T) {
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -826,7 +1160,7 @@ func Test_UpdatePhase_Scheduled_to_Scheduled_not_recreate_cron_job(t *testing.T)
 	u, err := helpers.GetStreamDefinitionUnstructured(t.Context(), k8sClient, objectName, helpers.GroupVersionKindV2)
 	require.NoError(t, err)
 
-	def, err := contracts.FromUnstructured(u)
+	def, err := contracts.FromUnstructured(u, nil)
 	require.NoError(t, err)
 
 	definitionHash, err := def.CurrentConfiguration(nil)
@@ -929,6 +1263,8 @@ func createReconciler(k8sClient client.Client, jobBuilder *mocks.MockJobBuilder)
 		recorder,
 		contracts.FromUnstructured,
 		backendResourceManagers,
-		backfillBackendResourceManager)
+		backfillBackendResourceManager,
+		stream.ControllerConfig{ApprovalsVerified: true},
+		nil)
 	return reconciler, recorder
 }
@@ -5,19 +5,53 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// CRDFoundCondition is the condition type reporting whether the custom resource definition of the streams is installed.
+const CRDFoundCondition = "CRDFound"
+
+// ControllerConfiguredCondition is the condition type reporting the StreamClass generation the stream controller was
+// started with.
+const ControllerConfiguredCondition = "ControllerConfigured"
+
+// DefaultRestartBackoff is the delay before the first restart of a stream controller that exited with an error.
+// The delay is doubled with every restart of the controller up to MaxRestartBackoff.
+const DefaultRestartBackoff = 5 * time.Second
+
+// MaxRestartBackoff is the maximum delay before a restart of a stream controller that exited with an error.
+const MaxRestartBackoff = 5 * time.Minute
+
+// crdWaitMinBackoff is the delay before the first check whether a missing custom resource definition has been
+// installed. The delay grows with the time the stream class has been waiting, up to crdWaitMaxBackoff.
+const crdWaitMinBackoff = 5 * time.Second
+
+// crdWaitMaxBackoff is the maximum delay between checks whether a missing custom resource definition has been
+// installed.
+const crdWaitMaxBackoff = 5 * time.Minute
+
+// controllerStopPollInterval is the delay between checks whether a cancelled stream controller has stopped.
+const controllerStopPollInterval = time.Second
+
 var _ reconcile.Reconciler = (*StreamClassReconciler)(nil)
 
 type StreamClassReconciler struct {
@@ -27,15 +61,21 @@ type StreamClassReconciler struct {
 	streamControllerFactory UnmanagedControllerFactory
 	reporter                StreamClassMetricsReporter
 	eventRecorder           record.EventRecorder
+	definitionParser        stream.DefinitionParser
+	restartBackoff          time.Duration
+	maxRestartBackoff       time.Duration
 }
 
-func NewStreamClassReconciler(client client.Client, streamControllerFactory UnmanagedControllerFactory, reporter StreamClassMetricsReporter, eventRecorder record.EventRecorder) *StreamClassReconciler {
+func NewStreamClassReconciler(client client.Client, streamControllerFactory UnmanagedControllerFactory, reporter StreamClassMetricsReporter, eventRecorder record.EventRecorder, definitionParser stream.DefinitionParser) *StreamClassReconciler {
 	return &StreamClassReconciler{
 		client:                  client,
 		streamControllers:       make(map[types.NamespacedName]*StreamControllerHandle),
 		streamControllerFactory: streamControllerFactory,
 		reporter:                reporter,
 		eventRecorder:           eventRecorder,
+		definitionParser:        definitionParser,
+		restartBackoff:          DefaultRestartBackoff,
+		maxRestartBackoff:       MaxRestartBackoff,
 	}
 }
 
@@ -53,11 +93,28 @@ func (s *StreamClassReconciler) Reconcile(ctx context.Context, request reconcile
 		logger.V(0).Error(err, "unable to get stream class")
 	}
 
+	if err == nil && sc.DeletionTimestamp.IsZero() {
+		err = s.updateFinalizer(ctx, sc)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	return s.moveFsm(ctx, sc, deleted, request.NamespacedName)
 }
 
 func (s *StreamClassReconciler) SetupWithManager(mgr runtime.Manager) error { // coverage-ignore (should be tested in e2e)
-	return runtime.NewControllerManagedBy(mgr).For(&v1.StreamClass{}).Complete(s)
+	// Only the metadata of custom resource definitions is watched, the name is enough to find the stream classes
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"})
+
+	return runtime.NewControllerManagedBy(mgr).
+		For(&v1.StreamClass{}).
+		Watches(crd, handler.EnqueueRequestsFromMapFunc(s.streamClassesForCRD), builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(event.UpdateEvent) bool { return false },
+			DeleteFunc: func(event.DeleteEvent) bool { return false },
+		})).
+		Complete(s)
 }
 
 func (s *StreamClassReconciler) moveFsm(ctx context.Context, sc *v1.StreamClass, deleted bool, name types.NamespacedName) (reconcile.Result, error) {
@@ -66,6 +123,9 @@ func (s *StreamClassReconciler) moveFsm(ctx context.Context, sc *v1.StreamClass,
 	case deleted:
 		return s.tryStopStreamController(ctx, name, nil)
 
+	case !sc.DeletionTimestamp.IsZero():
+		return s.finalize(ctx, sc, name)
+
 	case sc.Status.Phase == "":
 		return s.updatePhase(ctx, sc, v1.PhasePending, func() {
 			s.eventRecorder.Event(sc,
@@ -81,6 +141,10 @@ func (s *StreamClassReconciler) moveFsm(ctx context.Context, sc *v1.StreamClass,
 				"StreamClass is ready and stream controller has been started")
 		})
 	case sc.Status.Phase == v1.PhaseFailed:
+		if wait := s.remainingRestartBackoff(sc); wait > 0 {
+			logger.V(0).Info("Found StreamClass in Failed state, waiting before restarting the stream controller", "backoff", wait)
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
 		logger.V(0).Info("Found StreamClass in Failed state, attempting to recover")
 		return s.tryStartStreamController(ctx, sc, name, v1.PhaseReady, func() {
 			s.eventRecorder.Event(sc,
@@ -110,12 +174,59 @@ func (s *StreamClassReconciler) tryStartStreamController(ctx context.Context, sc
 
 	logger := klog.FromContext(ctx)
 
-	_, ok := s.streamControllers[name]
-	if ok {
+	reason := "ControllerStarted"
+	handle, ok := s.streamControllers[name]
+	if ok && !handle.stopping && !handle.alive() {
+		// The controller has exited without being stopped by the operator
+		logger.V(0).Info("stream controller is not running anymore")
+		s.removeFailedController(ctx, name, handle)
+		return s.recordControllerFailure(ctx, sc)
+	}
+
+	if ok && !handle.stopping && handle.generation == sc.Generation {
 		logger.V(0).Info("stream controller is already running")
 		return s.updatePhase(ctx, sc, nextPhase, eventFunc)
 	}
 
+	if ok && !handle.stopping {
+		logger.V(0).Info("StreamClass spec has changed, restarting stream controller",
+			"observedGeneration", handle.generation, "generation", sc.Generation)
+		handle.stop()
+		s.reporter.RemoveStreamClass(handle.gvk.Kind)
+		s.eventRecorder.Eventf(sc,
+			corev1.EventTypeNormal,
+			"StreamControllerRestarted",
+			"StreamClass spec has changed (generation %d), stream controller is restarted", sc.Generation)
+	}
+
+	// The new controller is only started once the previous one has stopped, so both never reconcile the same streams
+	if ok && handle.alive() {
+		logger.V(0).Info("waiting for the previous stream controller to stop")
+		return reconcile.Result{RequeueAfter: controllerStopPollInterval}, nil
+	}
+
+	if ok {
+		delete(s.streamControllers, name)
+		if handle.gvk != sc.TargetResourceGvk() {
+			// The informers for the new stream kind are registered when the new controller is created
+			err := s.streamControllerFactory.ReleaseStreamController(ctx, handle.gvk, name.Name)
+			if err != nil {
+				logger.V(0).Error(err, "unable to release the informers of the stream controller")
+			}
+		}
+		reason = "ControllerRestarted"
+	}
+
+	found, err := s.targetResourceExists(sc)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to check the custom resource definition of the stream class")
+		return reconcile.Result{}, err
+	}
+	if !found {
+		logger.V(0).Info("custom resource definition of the stream class is not installed", "gvk", sc.TargetResourceGvk())
+		return s.waitForTargetResource(ctx, sc)
+	}
+
 	controller, err := s.streamControllerFactory.CreateStreamController(ctx, sc.TargetResourceGvk(), sc)
 
 	if err != nil {
@@ -124,55 +235,247 @@ func (s *StreamClassReconciler) tryStartStreamController(ctx context.Context, sc
 	}
 
 	controllerContext, cancelFunc := context.WithCancel(ctx)
+	handle = &StreamControllerHandle{
+		cancelFunc: cancelFunc,
+		gvk:        sc.TargetResourceGvk(),
+		generation: sc.Generation,
+		done:       make(chan struct{}),
+	}
 	go func() {
 		err := controller.Start(controllerContext)
-		if errors.Is(err, context.Canceled) {
+		close(handle.done)
+		if err == nil || errors.Is(err, context.Canceled) {
 			logger.V(1).Info("stream controller is stopped")
 			return
 		}
-		if err != nil {
-			logger := klog.FromContext(ctx)
-			logger.V(0).Error(err, "stream controller exited with an error")
-
-			_, err = s.updatePhase(ctx, sc, v1.PhaseFailed, func() {
-				s.eventRecorder.Event(sc,
-					corev1.EventTypeWarning,
-					"StreamControllerError",
-					"Stream controller exited with error, StreamClass moved to Failed state")
-			})
-			if err != nil {
-				logger := klog.FromContext(ctx)
-				logger.V(0).Error(err, "unable to update StreamClass phase to Failed after stream controller exited with error")
-			}
-		}
+		logger.V(0).Error(err, "stream controller exited with an error")
+		s.handleControllerFailure(ctx, name, handle)
 	}()
 
 	logger.V(0).Info("controller is started")
-	s.streamControllers[name] = &StreamControllerHandle{
-		cancelFunc: cancelFunc,
-		gvk:        sc.TargetResourceGvk(),
-	}
+	s.streamControllers[name] = handle
 	s.reporter.AddStreamClass(sc.TargetResourceGvk().Kind, "stream_class", sc.MetricsTags())
 
+	if sc.Status.Phase == v1.PhaseFailed && sc.Status.LastControllerFailureTime != nil {
+		sc.Status.ControllerRestarts++
+		s.reporter.ReportControllerRestart(sc.TargetResourceGvk().Kind, sc.MetricsTags())
+		reason = "ControllerRecovered"
+	}
+
+	sc.Status.ControllerStartTime = new(metav1.Now())
+	meta.SetStatusCondition(&sc.Status.Conditions, metav1.Condition{
+		Type:    CRDFoundCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  "CRDFound",
+		Message: fmt.Sprintf("The custom resource definition for %s is installed", sc.TargetResourceGvk()),
+	})
+	meta.SetStatusCondition(&sc.Status.Conditions, metav1.Condition{
+		Type:               ControllerConfiguredCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: sc.Generation,
+		Reason:             reason,
+		Message:            fmt.Sprintf("Stream controller is running with generation %d of the StreamClass", sc.Generation),
+	})
+	err = s.client.Status().Update(ctx, sc)
+	if client.IgnoreNotFound(err) != nil {
+		logger.V(0).Error(err, "unable to update Stream Class controller start time")
+		return reconcile.Result{}, err
+	}
+
 	return s.updatePhase(ctx, sc, nextPhase, eventFunc)
 }
 
+// handleControllerFailure removes the handle of a stream controller that exited with an error and records the failure.
+func (s *StreamClassReconciler) handleControllerFailure(ctx context.Context, name types.NamespacedName, handle *StreamControllerHandle) {
+	logger := klog.FromContext(ctx)
+
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+
+	// The handle might have been removed or cancelled by a reconciliation while the controller was exiting
+	if s.streamControllers[name] != handle || handle.stopping {
+		logger.V(1).Info("stream controller handle has already been removed, skipping failure handling")
+		return
+	}
+	s.removeFailedController(ctx, name, handle)
+
+	sc := &v1.StreamClass{}
+	err := s.client.Get(ctx, name, sc)
+	if apierrors.IsNotFound(err) { // coverage-ignore
+		logger.V(1).Info("stream class not found, might have been deleted")
+		return
+	}
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to get StreamClass after stream controller exited with error")
+		return
+	}
+
+	_, err = s.recordControllerFailure(ctx, sc)
+	if err != nil { // coverage-ignore
+		logger.V(0).Error(err, "unable to update StreamClass phase to Failed after stream controller exited with error")
+	}
+}
+
+// removeFailedController removes the handle of a stream controller that is not running anymore and releases its
+// informers, so they are not kept if the stream class is deleted before the controller is restarted.
+func (s *StreamClassReconciler) removeFailedController(ctx context.Context, name types.NamespacedName, handle *StreamControllerHandle) {
+	s.reporter.RemoveStreamClass(handle.gvk.Kind)
+	delete(s.streamControllers, name)
+
+	err := s.streamControllerFactory.ReleaseStreamController(ctx, handle.gvk, name.Name)
+	if err != nil { // coverage-ignore
+		klog.FromContext(ctx).V(0).Error(err, "unable to release the informers of the stream controller")
+	}
+}
+
+// recordControllerFailure moves the stream class to the Failed phase. The stream controller is restarted by the
+// Failed phase recovery once the restart backoff has elapsed.
+func (s *StreamClassReconciler) recordControllerFailure(ctx context.Context, sc *v1.StreamClass) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx)
+
+	backoff := s.restartBackoffFor(sc)
+	sc.Status.Phase = v1.PhaseFailed
+	sc.Status.LastControllerFailureTime = new(metav1.Now())
+	err := s.client.Status().Update(ctx, sc)
+	if client.IgnoreNotFound(err) != nil {
+		logger.V(0).Error(err, "unable to update Stream Class phase")
+		return reconcile.Result{}, err
+	}
+
+	s.eventRecorder.Eventf(sc,
+		corev1.EventTypeWarning,
+		"StreamControllerError",
+		"Stream controller exited unexpectedly, StreamClass moved to Failed state, restarting in %s", backoff)
+	return reconcile.Result{RequeueAfter: backoff}, nil
+}
+
+// restartBackoffFor returns the delay before the stream controller of the class is restarted after a failure.
+// The delay grows exponentially with the number of restarts of the controller.
+func (s *StreamClassReconciler) restartBackoffFor(sc *v1.StreamClass) time.Duration {
+	backoff := s.restartBackoff
+	for i := int32(0); i < sc.Status.ControllerRestarts && backoff < s.maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, s.maxRestartBackoff)
+}
+
+// remainingRestartBackoff returns the time left until the stream controller of the failed class can be restarted.
+func (s *StreamClassReconciler) remainingRestartBackoff(sc *v1.StreamClass) time.Duration {
+	if sc.Status.LastControllerFailureTime == nil {
+		return 0
+	}
+	return time.Until(sc.Status.LastControllerFailureTime.Add(s.restartBackoffFor(sc)))
+}
+
+// targetResourceExists returns true if the custom resource definition of the streams of the class is installed.
+func (s *StreamClassReconciler) targetResourceExists(sc *v1.StreamClass) (bool, error) {
+	gvk := sc.TargetResourceGvk()
+	_, err := s.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// waitForTargetResource keeps the stream class pending until the custom resource definition of its streams is
+// installed and established. The stream class is reconciled again once the custom resource definition is created, and
+// requeued with a growing backoff in case the definition was not established yet when it was created.
+func (s *StreamClassReconciler) waitForTargetResource(ctx context.Context, sc *v1.StreamClass) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx)
+	message := fmt.Sprintf("The custom resource definition %s.%s for %s is not installed",
+		sc.Spec.PluralName, sc.Spec.APIGroupRef, sc.TargetResourceGvk())
+
+	changed := meta.SetStatusCondition(&sc.Status.Conditions, metav1.Condition{
+		Type:    CRDFoundCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  "CRDNotFound",
+		Message: message,
+	})
+	if changed {
+		s.eventRecorder.Event(sc, corev1.EventTypeWarning, "CRDNotFound", message)
+		err := s.client.Status().Update(ctx, sc)
+		if client.IgnoreNotFound(err) != nil {
+			logger.V(0).Error(err, "unable to update Stream Class conditions")
+			return reconcile.Result{}, err
+		}
+	}
+
+	result, err := s.updatePhase(ctx, sc, v1.PhasePending, nil)
+	if err != nil {
+		return result, err
+	}
+	return reconcile.Result{RequeueAfter: crdWaitBackoff(sc)}, nil
+}
+
+// crdWaitBackoff returns the delay before checking again whether the custom resource definition of the stream class
+// is installed. The delay equals the time the stream class has been waiting for the definition, bounded by
+// crdWaitMinBackoff and crdWaitMaxBackoff, so the checks slow down exponentially.
+func crdWaitBackoff(sc *v1.StreamClass) time.Duration {
+	condition := meta.FindStatusCondition(sc.Status.Conditions, CRDFoundCondition)
+	if condition == nil { // coverage-ignore (the condition is set before the backoff is computed)
+		return crdWaitMinBackoff
+	}
+	return min(max(time.Since(condition.LastTransitionTime.Time), crdWaitMinBackoff), crdWaitMaxBackoff)
+}
+
+// streamClassesForCRD maps a custom resource definition to the stream classes referencing it.
+func (s *StreamClassReconciler) streamClassesForCRD(ctx context.Context, crd client.Object) []reconcile.Request { // coverage-ignore (should be tested in e2e)
+	streamClasses := &v1.StreamClassList{}
+	err := s.client.List(ctx, streamClasses)
+	if err != nil {
+		klog.FromContext(ctx).V(0).Error(err, "unable to list stream classes for custom resource definition", "crd", crd.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sc := range streamClasses.Items {
+		if sc.Spec.PluralName+"."+sc.Spec.APIGroupRef == crd.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: sc.Name}})
+		}
+	}
+	return requests
+}
+
 func (s *StreamClassReconciler) tryStopStreamController(ctx context.Context, name types.NamespacedName, eventFunc controllers.EventFunc) (reconcile.Result, error) {
+	stopped, err := s.stopStreamController(ctx, name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !stopped {
+		return reconcile.Result{RequeueAfter: controllerStopPollInterval}, nil
+	}
+	return s.updatePhase(ctx, nil, v1.PhaseStopped, eventFunc)
+}
+
+// stopStreamController cancels the stream controller of the stream class, if it is running, and releases its
+// informers once the controller has stopped. Returns false if the controller is still stopping.
+func (s *StreamClassReconciler) stopStreamController(ctx context.Context, name types.NamespacedName) (bool, error) {
 	s.rwLock.Lock()
 	defer s.rwLock.Unlock()
 
 	logger := klog.FromContext(ctx)
-	_, ok := s.streamControllers[name]
+	handle, ok := s.streamControllers[name]
 	if !ok {
 		logger.V(0).Info("Stream controller is not running")
-		return reconcile.Result{}, nil
+		return true, nil
+	}
+	if !handle.stopping {
+		handle.stop()
+		s.reporter.RemoveStreamClass(handle.gvk.Kind)
+	}
+	if handle.alive() {
+		logger.V(0).Info("waiting for the stream controller to stop")
+		return false, nil
 	}
-	s.streamControllers[name].cancelFunc()
 	logger.V(0).Info("Stream controller is stopped")
-	s.reporter.RemoveStreamClass(s.streamControllers[name].gvk.Kind)
-
 	delete(s.streamControllers, name)
-	return s.updatePhase(ctx, nil, v1.PhaseStopped, eventFunc)
+
+	err := s.streamControllerFactory.ReleaseStreamController(ctx, handle.gvk, name.Name)
+	if err != nil {
+		logger.V(0).Error(err, "unable to release the informers of the stream controller")
+		return true, err
+	}
+	return true, nil
 }
 
 func (s *StreamClassReconciler) updatePhase(ctx context.Context, sc *v1.StreamClass, nextPhase v1.Phase, eventFunc controllers.EventFunc) (reconcile.Result, error) {
@@ -0,0 +1,174 @@
+package stream_class
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// StreamClassFinalizer is the finalizer that keeps a StreamClass until its deletion policy has been applied.
+const StreamClassFinalizer = "streaming.sneaksanddata.com/stream-class"
+
+// updateFinalizer adds the StreamClassFinalizer to a stream class with a deletion policy that changes its streams and
+// removes it once the deletion policy is changed back to Orphan, which requires no action on deletion.
+func (s *StreamClassReconciler) updateFinalizer(ctx context.Context, sc *v1.StreamClass) error {
+	logger := klog.FromContext(ctx)
+
+	var changed bool
+	if requiresFinalizer(sc) {
+		changed = controllerutil.AddFinalizer(sc, StreamClassFinalizer)
+	} else {
+		changed = controllerutil.RemoveFinalizer(sc, StreamClassFinalizer)
+	}
+	if !changed {
+		return nil
+	}
+
+	err := s.client.Update(ctx, sc)
+	if err != nil {
+		logger.V(0).Error(err, "unable to update finalizer of Stream Class")
+		return err
+	}
+	return nil
+}
+
+// requiresFinalizer returns true if the deletion policy of the stream class must be applied before it is removed.
+func requiresFinalizer(sc *v1.StreamClass) bool {
+	return sc.Spec.DeletionPolicy != "" && sc.Spec.DeletionPolicy != v1.DeletionPolicyOrphan
+}
+
+// finalize stops the stream controller of a deleted stream class, applies the deletion policy of the class to its
+// streams and removes the finalizer, so the stream class can be released.
+func (s *StreamClassReconciler) finalize(ctx context.Context, sc *v1.StreamClass, name types.NamespacedName) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(sc, StreamClassFinalizer) {
+		return s.tryStopStreamController(ctx, name, nil)
+	}
+
+	// The deletion policy is applied once the stream controller has stopped, so it does not recreate the workloads
+	stopped, err := s.stopStreamController(ctx, name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !stopped {
+		return reconcile.Result{RequeueAfter: controllerStopPollInterval}, nil
+	}
+
+	policy := sc.Spec.DeletionPolicy
+	if policy == "" {
+		policy = v1.DeletionPolicyOrphan
+	}
+
+	logger.V(0).Info("Applying StreamClass deletion policy", "deletionPolicy", policy)
+	count, err := s.applyDeletionPolicy(ctx, sc, policy)
+	if err != nil {
+		logger.V(0).Error(err, "unable to apply StreamClass deletion policy", "deletionPolicy", policy)
+		s.eventRecorder.Eventf(sc,
+			corev1.EventTypeWarning,
+			"DeletionPolicyFailed",
+			"Unable to apply deletion policy %s: %s", policy, err)
+		return reconcile.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(sc, StreamClassFinalizer)
+	err = s.client.Update(ctx, sc)
+	if client.IgnoreNotFound(err) != nil {
+		logger.V(0).Error(err, "unable to remove finalizer from Stream Class")
+		return reconcile.Result{}, err
+	}
+
+	s.eventRecorder.Eventf(sc,
+		corev1.EventTypeNormal,
+		"StreamClassDeleted",
+		"Deletion policy %s has been applied to %d streams", policy, count)
+	return reconcile.Result{}, nil
+}
+
+// applyDeletionPolicy applies the deletion policy to the streams of the stream class and returns the number of
+// streams the policy was applied to.
+func (s *StreamClassReconciler) applyDeletionPolicy(ctx context.Context, sc *v1.StreamClass, policy v1.DeletionPolicy) (int, error) {
+	if policy == v1.DeletionPolicyOrphan {
+		return 0, nil
+	}
+
+	streams, err := stream.ListStreamsForClass(ctx, s.client, sc)
+	if meta.IsNoMatchError(err) {
+		// The custom resource definition of the streams is not installed, so there are no streams to process
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to list streams: %w", err)
+	}
+
+	for i := range streams {
+		if policy == v1.DeletionPolicySuspendStreams {
+			err = s.suspendStream(ctx, sc, &streams[i])
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		err = s.deleteWorkloads(ctx, &streams[i])
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(streams), nil
+}
+
+// suspendStream sets the suspended flag in the spec of the stream.
+func (s *StreamClassReconciler) suspendStream(ctx context.Context, sc *v1.StreamClass, obj *unstructured.Unstructured) error {
+	definition, err := s.definitionParser(obj, sc.Spec.Defaults)
+	if err != nil {
+		return fmt.Errorf("failed to parse stream %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	if definition.Suspended() {
+		return nil
+	}
+
+	err = definition.SetSuspended(true)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to suspend stream %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	err = s.client.Update(ctx, definition.ToUnstructured())
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to suspend stream %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// deleteWorkloads removes the Job and the CronJob created for the stream.
+// Objects with the same name that are not controlled by the stream are left unchanged.
+func (s *StreamClassReconciler) deleteWorkloads(ctx context.Context, obj *unstructured.Unstructured) error {
+	name := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	for _, workload := range []client.Object{&batchv1.Job{}, &batchv1.CronJob{}} {
+		err := s.client.Get(ctx, name, workload)
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get workload of stream %s: %w", name, err)
+		}
+		if err != nil || !metav1.IsControlledBy(workload, obj) {
+			continue
+		}
+
+		err = s.client.Delete(ctx, workload, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete workload of stream %s: %w", name, err)
+		}
+	}
+	return nil
+}
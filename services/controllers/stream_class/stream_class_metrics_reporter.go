@@ -8,4 +8,8 @@ type StreamClassMetricsReporter interface {
 
 	// RemoveStreamClass unregisters the stream class of the specified kind from metrics reporting.
 	RemoveStreamClass(kind string)
+
+	// ReportControllerRestart reports that the stream controller for the stream class of the specified kind was
+	// restarted after a failure.
+	ReportControllerRestart(kind string, tags map[string]string)
 }
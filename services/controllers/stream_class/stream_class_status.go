@@ -0,0 +1,133 @@
+package stream_class
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultStatusUpdateInterval is the default interval between updates of the stream counters in the StreamClass status.
+const DefaultStatusUpdateInterval = 30 * time.Second
+
+// newStreamPhase is the name used in the stream counters for streams that have not been processed yet.
+const newStreamPhase = "New"
+
+var _ reconcile.Reconciler = (*StreamClassStatusReconciler)(nil)
+
+// StreamClassStatusReconciler maintains the stream and backfill counters in the StreamClass status.
+// Streams are not watched by this reconciler: the counters are refreshed periodically with the given interval,
+// so the status is updated at most once per interval regardless of how often the streams change.
+type StreamClassStatusReconciler struct {
+	client   client.Client
+	interval time.Duration
+}
+
+func NewStreamClassStatusReconciler(client client.Client, interval time.Duration) *StreamClassStatusReconciler {
+	return &StreamClassStatusReconciler{
+		client:   client,
+		interval: interval,
+	}
+}
+
+func (r *StreamClassStatusReconciler) SetupWithManager(mgr runtime.Manager) error { // coverage-ignore (should be tested in e2e)
+	return runtime.NewControllerManagedBy(mgr).
+		Named("streamclass-status").
+		For(&v1.StreamClass{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+func (r *StreamClassStatusReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := klog.FromContext(ctx).WithValues("streamClass", request.Name)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(1).Info("Updating StreamClass status counters")
+
+	sc := &v1.StreamClass{}
+	err := r.client.Get(ctx, request.NamespacedName, sc)
+	if apierrors.IsNotFound(err) { // coverage-ignore
+		logger.V(1).Info("stream class not found, might have been deleted")
+		return reconcile.Result{}, nil
+	}
+	if err != nil { // coverage-ignore
+		return reconcile.Result{}, err
+	}
+
+	status := sc.Status.DeepCopy()
+	status.ObservedGeneration = sc.Generation
+
+	// The stream resource might not be served until the stream controller is running
+	if sc.Status.Phase == v1.PhaseReady {
+		err = r.countStreams(ctx, sc, status)
+		if err != nil {
+			logger.V(0).Error(err, "unable to count streams")
+			return reconcile.Result{}, err
+		}
+	}
+
+	err = r.countActiveBackfills(ctx, sc, status)
+	if err != nil {
+		logger.V(0).Error(err, "unable to count active backfills")
+		return reconcile.Result{}, err
+	}
+
+	// Only the counters and the observed generation are patched, the rest of the status is owned by the
+	// StreamClassReconciler
+	if !equality.Semantic.DeepEqual(&sc.Status, status) {
+		patch := client.MergeFrom(sc.DeepCopy())
+		sc.Status.ObservedGeneration = status.ObservedGeneration
+		sc.Status.Streams = status.Streams
+		sc.Status.TotalStreams = status.TotalStreams
+		sc.Status.ActiveBackfills = status.ActiveBackfills
+		err = r.client.Status().Patch(ctx, sc, patch)
+		if client.IgnoreNotFound(err) != nil {
+			logger.V(0).Error(err, "unable to update StreamClass status")
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+func (r *StreamClassStatusReconciler) countStreams(ctx context.Context, sc *v1.StreamClass, status *v1.StreamClassStatus) error {
+	streams, err := stream.ListStreamsForClass(ctx, r.client, sc)
+	if err != nil {
+		return err
+	}
+
+	status.Streams = make(map[string]int32)
+	for _, s := range streams {
+		phase, _, err := unstructured.NestedString(s.Object, "status", "phase")
+		if err != nil || phase == string(stream.New) {
+			phase = newStreamPhase
+		}
+		status.Streams[phase]++
+	}
+	status.TotalStreams = int32(len(streams))
+	return nil
+}
+
+func (r *StreamClassStatusReconciler) countActiveBackfills(ctx context.Context, sc *v1.StreamClass, status *v1.StreamClassStatus) error {
+	requests := &v1.BackfillRequestList{}
+	err := r.client.List(ctx, requests)
+	if err != nil {
+		return err
+	}
+
+	status.ActiveBackfills = 0
+	for _, bfr := range requests.Items {
+		if bfr.Spec.StreamClass == sc.Name && !bfr.Spec.Completed {
+			status.ActiveBackfills++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package stream_class
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var streamClassName = types.NamespacedName{Name: "stream-class"}
+
+func Test_StatusReconcile_Counts_Streams_And_Backfills(t *testing.T) {
+	// Arrange
+	k8sClient := setupStatusFakeClient(t, v1.PhaseReady,
+		newMockStream("stream-a", "Running"),
+		newMockStream("stream-b", "Running"),
+		newMockStream("stream-c", "Failed"),
+		newMockStream("stream-d", ""),
+		newBackfillRequest("backfill-a", "stream-class", false),
+		newBackfillRequest("backfill-b", "stream-class", true),
+		newBackfillRequest("backfill-c", "other-class", false),
+	)
+	reconciler := NewStreamClassStatusReconciler(k8sClient, time.Minute)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, time.Minute, result.RequeueAfter)
+	sc := getStreamClass(t, k8sClient)
+	require.Equal(t, map[string]int32{"Running": 2, "Failed": 1, "New": 1}, sc.Status.Streams)
+	require.Equal(t, int32(4), sc.Status.TotalStreams)
+	require.Equal(t, int32(1), sc.Status.ActiveBackfills)
+	require.Equal(t, v1.PhaseReady, sc.Status.Phase)
+}
+
+func Test_StatusReconcile_Observed_Generation(t *testing.T) {
+	// Arrange
+	k8sClient := setupStatusFakeClient(t, v1.PhaseReady, newMockStream("stream-a", "Running"))
+	sc := getStreamClass(t, k8sClient)
+	sc.Generation = 3
+	require.NoError(t, k8sClient.Update(t.Context(), sc))
+	reconciler := NewStreamClassStatusReconciler(k8sClient, time.Minute)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+
+	// Assert
+	sc = getStreamClass(t, k8sClient)
+	require.Equal(t, int64(3), sc.Generation)
+	require.Equal(t, sc.Generation, sc.Status.ObservedGeneration)
+}
+
+func Test_StatusReconcile_Skips_Unchanged_Status(t *testing.T) {
+	// Arrange
+	k8sClient := setupStatusFakeClient(t, v1.PhaseReady, newMockStream("stream-a", "Running"))
+	reconciler := NewStreamClassStatusReconciler(k8sClient, time.Minute)
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+	resourceVersion := getStreamClass(t, k8sClient).ResourceVersion
+
+	// Act
+	_, err = reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, resourceVersion, getStreamClass(t, k8sClient).ResourceVersion)
+}
+
+func Test_StatusReconcile_Concurrent_Status_Update(t *testing.T) {
+	// Arrange
+	startTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	updated := false
+	k8sClient := interceptor.NewClient(setupStatusFakeClient(t, v1.PhaseReady, newMockStream("stream-a", "Running")).(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			err := c.Get(ctx, key, obj, opts...)
+			sc, ok := obj.(*v1.StreamClass)
+			if err != nil || !ok || updated {
+				return err
+			}
+
+			// The StreamClassReconciler updates the status after the counters have been read
+			updated = true
+			concurrent := sc.DeepCopy()
+			concurrent.Status.ControllerStartTime = &startTime
+			return c.Status().Update(ctx, concurrent)
+		},
+	})
+	reconciler := NewStreamClassStatusReconciler(k8sClient, time.Minute)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+
+	// Assert
+	sc := getStreamClass(t, k8sClient)
+	require.Equal(t, map[string]int32{"Running": 1}, sc.Status.Streams)
+	require.Equal(t, int32(1), sc.Status.TotalStreams)
+	require.Equal(t, &startTime, sc.Status.ControllerStartTime)
+}
+
+func Test_StatusReconcile_Pending_StreamClass(t *testing.T) {
+	// Arrange
+	k8sClient := setupStatusFakeClient(t, v1.PhasePending,
+		newMockStream("stream-a", "Running"),
+		newBackfillRequest("backfill-a", "stream-class", false),
+	)
+	reconciler := NewStreamClassStatusReconciler(k8sClient, time.Minute)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: streamClassName})
+	require.NoError(t, err)
+
+	// Assert
+	sc := getStreamClass(t, k8sClient)
+	require.Empty(t, sc.Status.Streams)
+	require.Equal(t, int32(1), sc.Status.ActiveBackfills)
+}
+
+func newMockStream(name string, phase string) client.Object {
+	return &testv1.MockStreamDefinition{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Status:     testv1.MockStreamDefinitionStatus{Phase: phase},
+	}
+}
+
+func newBackfillRequest(name string, streamClass string, completed bool) client.Object {
+	return &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: streamClass,
+			StreamId:    "stream-a",
+			Completed:   completed,
+		},
+	}
+}
+
+func setupStatusFakeClient(t *testing.T, phase v1.Phase, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, testv1.AddToScheme(scheme))
+
+	sc := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Name: streamClassName.Name, Generation: 3},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		},
+		Status: v1.StreamClassStatus{Phase: phase},
+	}
+
+	return crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1.StreamClass{}).
+		WithObjects(append(objects, sc)...).
+		Build()
+}
+
+func getStreamClass(t *testing.T, k8sClient client.Client) *v1.StreamClass {
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), streamClassName, sc))
+	return sc
+}
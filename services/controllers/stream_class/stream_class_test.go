@@ -3,19 +3,28 @@ package stream_class
 import (
 	"context"
 	"fmt"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts"
 	"github.com/SneaksAndData/arcane-operator/tests/mocks"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -27,7 +36,7 @@ func Test_UpdatePhase_ToPending(t *testing.T) {
 	k8sClient, name := setupFakeClient(t, &v1.StreamClass{ObjectMeta: metav1.ObjectMeta{}})
 	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
 	recorder := record.NewFakeRecorder(10)
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, mocks.NewMockStreamClassMetricsReporter(mockCtrl), recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, mocks.NewMockStreamClassMetricsReporter(mockCtrl), recorder, contracts.FromUnstructured)
 
 	// Act
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -36,6 +45,42 @@ func Test_UpdatePhase_ToPending(t *testing.T) {
 
 	// Assert
 	expectPhase(t, k8sClient, name, v1.PhasePending)
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+	require.NotContains(t, sc.Finalizers, StreamClassFinalizer)
+}
+
+func Test_Finalizer_Follows_DeletionPolicy(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	// The stream controller is not started while the stream class waits for the restart backoff
+	k8sClient, name := setupFakeClient(t, &v1.StreamClass{
+		Status: v1.StreamClassStatus{Phase: v1.PhaseFailed, LastControllerFailureTime: new(metav1.Now())},
+	})
+	sc := &v1.StreamClass{}
+	reconciler := NewStreamClassReconciler(k8sClient, mocks.NewMockUnmanagedControllerFactory(mockCtrl), mocks.NewMockStreamClassMetricsReporter(mockCtrl), record.NewFakeRecorder(10), contracts.FromUnstructured)
+
+	for _, tc := range []struct {
+		policy    v1.DeletionPolicy
+		finalizer bool
+	}{
+		{policy: v1.DeletionPolicySuspendStreams, finalizer: true},
+		{policy: v1.DeletionPolicyDeleteWorkloads, finalizer: true},
+		{policy: v1.DeletionPolicyOrphan, finalizer: false},
+	} {
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+		sc.Spec.DeletionPolicy = tc.policy
+		require.NoError(t, k8sClient.Update(t.Context(), sc))
+
+		// Act
+		_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+
+		// Assert
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+		require.Equal(t, tc.finalizer, slices.Contains(sc.Finalizers, StreamClassFinalizer), "deletion policy %s", tc.policy)
+	}
 }
 
 func Test_UpdatePhase_ToRunning(t *testing.T) {
@@ -52,9 +97,12 @@ func Test_UpdatePhase_ToRunning(t *testing.T) {
 
 	started := make(chan bool, 10)
 	streamController := mocks.NewMockController[reconcile.Request](mockCtrl)
-	streamController.EXPECT().Start(gomock.Any()).Do(func(ctx context.Context) {
+	streamController.EXPECT().Start(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
 		started <- true
 		// Do not close the channel here to allow multiple calls. It will make the test easier to reason about.
+		// Keep running like a real controller, otherwise the controller is considered as failed.
+		<-ctx.Done()
+		return ctx.Err()
 	})
 
 	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
@@ -63,7 +111,7 @@ func Test_UpdatePhase_ToRunning(t *testing.T) {
 	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any())
 	recorder := record.NewFakeRecorder(10)
 
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Act
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -75,6 +123,10 @@ func Test_UpdatePhase_ToRunning(t *testing.T) {
 
 	// Assert
 	expectPhase(t, k8sClient, name, v1.PhaseReady)
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+	require.NotNil(t, sc.Status.ControllerStartTime)
+	require.True(t, meta.IsStatusConditionTrue(sc.Status.Conditions, CRDFoundCondition))
 }
 
 func Test_UpdatePhase_ToRunning_Idempotence(t *testing.T) {
@@ -91,9 +143,12 @@ func Test_UpdatePhase_ToRunning_Idempotence(t *testing.T) {
 
 	started := make(chan bool, 10)
 	streamController := mocks.NewMockController[reconcile.Request](mockCtrl)
-	streamController.EXPECT().Start(gomock.Any()).Do(func(ctx context.Context) {
+	streamController.EXPECT().Start(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
 		started <- true
 		// Do not close the channel here to allow multiple calls. It will make the test easier to reason about.
+		// Keep running like a real controller, otherwise the controller is considered as failed.
+		<-ctx.Done()
+		return ctx.Err()
 	})
 
 	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
@@ -102,7 +157,7 @@ func Test_UpdatePhase_ToRunning_Idempotence(t *testing.T) {
 	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any())
 	recorder := record.NewFakeRecorder(10)
 
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Act
 	for i := 0; i < 5; i++ {
@@ -118,6 +173,160 @@ func Test_UpdatePhase_ToRunning_Idempotence(t *testing.T) {
 	expectPhase(t, k8sClient, name, v1.PhaseReady)
 }
 
+func Test_UpdatePhase_Ready_Restart_On_Spec_Change(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	k8sClient, name := setupFakeClient(t, &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: v1.StreamClassStatus{
+			Phase: v1.PhaseReady,
+		},
+	})
+
+	cancelled := make(chan bool, 10)
+	stop := make(chan struct{})
+	oldController := mocks.NewMockController[reconcile.Request](mockCtrl)
+	oldController.EXPECT().Start(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		<-ctx.Done()
+		cancelled <- true
+		<-stop
+		return ctx.Err()
+	})
+	newController := mocks.NewMockController[reconcile.Request](mockCtrl)
+	newController.EXPECT().Start(gomock.Any()).AnyTimes()
+
+	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
+	gomock.InOrder(
+		streamReconcilerFactory.EXPECT().CreateStreamController(gomock.Any(), gomock.Any(), gomock.Any()).Return(oldController, nil),
+		streamReconcilerFactory.EXPECT().CreateStreamController(gomock.Any(), gomock.Any(), gomock.Any()).Return(newController, nil),
+	)
+	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
+	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+	metricsMock.EXPECT().RemoveStreamClass(gomock.Any())
+	recorder := record.NewFakeRecorder(10)
+
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
+
+	// Start the stream controller first
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+	sc.Spec.SecretRefs = []string{"connectionString"}
+	sc.Generation = 2
+	require.NoError(t, k8sClient.Update(t.Context(), sc))
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+
+	// Assert
+	<-cancelled
+	require.Equal(t, controllerStopPollInterval, result.RequeueAfter, "the new controller must wait for the old one to stop")
+
+	// Act
+	close(stop)
+	require.Eventually(t, func() bool {
+		result, err = reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		return result == reconcile.Result{}
+	}, time.Second, 10*time.Millisecond)
+
+	// Assert
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+	require.Equal(t, v1.PhaseReady, sc.Status.Phase)
+	condition := meta.FindStatusCondition(sc.Status.Conditions, ControllerConfiguredCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, "ControllerRestarted", condition.Reason)
+	require.Equal(t, int64(2), condition.ObservedGeneration)
+	require.Contains(t, <-recorder.Events, "StreamControllerRestarted")
+}
+
+func Test_UpdatePhase_Pending_CRD_Not_Found(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	k8sClient, name := setupFakeClient(t, &v1.StreamClass{
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: "streaming.sneaksanddata.com",
+			APIVersion:  "v1",
+			KindRef:     "MissingStream",
+			PluralName:  "missingstreams",
+		},
+		Status: v1.StreamClassStatus{
+			Phase: v1.PhasePending,
+		},
+	})
+
+	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
+	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
+	recorder := record.NewFakeRecorder(10)
+
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
+
+	// Act
+	for i := 0; i < 2; i++ {
+		result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		require.Equal(t, reconcile.Result{RequeueAfter: crdWaitMinBackoff}, result)
+	}
+
+	// Assert
+	expectPhase(t, k8sClient, name, v1.PhasePending)
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc))
+	condition := meta.FindStatusCondition(sc.Status.Conditions, CRDFoundCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, "CRDNotFound", condition.Reason)
+	require.Contains(t, condition.Message, "missingstreams.streaming.sneaksanddata.com")
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "CRDNotFound")
+}
+
+func Test_UpdatePhase_Pending_CRD_Not_Found_Backoff(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	k8sClient, name := setupFakeClient(t, &v1.StreamClass{
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: "streaming.sneaksanddata.com",
+			APIVersion:  "v1",
+			KindRef:     "MissingStream",
+			PluralName:  "missingstreams",
+		},
+		Status: v1.StreamClassStatus{
+			Phase: v1.PhasePending,
+			Conditions: []metav1.Condition{{
+				Type:               CRDFoundCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             "CRDNotFound",
+				Message:            "The custom resource definition missingstreams.streaming.sneaksanddata.com is not installed",
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			}},
+		},
+	})
+
+	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
+	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
+	recorder := record.NewFakeRecorder(10)
+
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{RequeueAfter: crdWaitMaxBackoff}, result)
+	expectPhase(t, k8sClient, name, v1.PhasePending)
+}
+
 func Test_UpdatePhase_Ready_ToStopped(t *testing.T) {
 	// Arrange
 	mockCtrl := gomock.NewController(t)
@@ -130,17 +339,29 @@ func Test_UpdatePhase_Ready_ToStopped(t *testing.T) {
 		},
 	})
 
+	stop := make(chan struct{})
+	var controllerStopped atomic.Bool
 	streamController := mocks.NewMockController[reconcile.Request](mockCtrl)
-	streamController.EXPECT().Start(gomock.Any()).AnyTimes()
+	streamController.EXPECT().Start(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		<-ctx.Done()
+		<-stop
+		controllerStopped.Store(true)
+		return ctx.Err()
+	})
 
 	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
 	streamReconcilerFactory.EXPECT().CreateStreamController(gomock.Any(), gomock.Any(), gomock.Any()).Return(streamController, nil)
+	streamReconcilerFactory.EXPECT().ReleaseStreamController(gomock.Any(), testv1.SchemeGroupVersion.WithKind("MockStreamDefinition"), name).
+		DoAndReturn(func(context.Context, schema.GroupVersionKind, string) error {
+			require.True(t, controllerStopped.Load(), "the informers must be released after the controller has stopped")
+			return nil
+		})
 	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
 	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any())
 	metricsMock.EXPECT().RemoveStreamClass(gomock.Any())
 	recorder := record.NewFakeRecorder(10)
 
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Start the stream controller first
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -161,7 +382,15 @@ func Test_UpdatePhase_Ready_ToStopped(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	require.Equal(t, result, reconcile.Result{})
+	require.Equal(t, controllerStopPollInterval, result.RequeueAfter)
+
+	// Act
+	close(stop)
+	require.Eventually(t, func() bool {
+		result, err = reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		return result == reconcile.Result{}
+	}, time.Second, 10*time.Millisecond)
 }
 
 func Test_UpdatePhase_Pending_ToStopped(t *testing.T) {
@@ -177,7 +406,7 @@ func Test_UpdatePhase_Pending_ToStopped(t *testing.T) {
 	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
 	recorder := record.NewFakeRecorder(10)
 
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Transit the stream class to Pending state first
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -219,7 +448,7 @@ func Test_UpdatePhase_Pending_ToFailed(t *testing.T) {
 	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
 	recorder := record.NewFakeRecorder(10)
 
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Act
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -261,7 +490,7 @@ func Test_UpdatePhase_Ready_ToFailed(t *testing.T) {
 	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any())
 
 	recorder := record.NewFakeRecorder(10)
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Start the stream controller first
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -301,7 +530,7 @@ func Test_UpdatePhase_Failed_ToReady(t *testing.T) {
 	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any())
 
 	recorder := record.NewFakeRecorder(10)
-	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder)
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
 
 	// Start the stream controller first
 	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
@@ -315,6 +544,190 @@ func Test_UpdatePhase_Failed_ToReady(t *testing.T) {
 	expectPhase(t, k8sClient, name, v1.PhaseReady)
 }
 
+func Test_UpdatePhase_Ready_Restart_After_Controller_Failure(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	k8sClient, name := setupFakeClient(t, &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{},
+		Status: v1.StreamClassStatus{
+			Phase: v1.PhaseReady,
+		},
+	})
+
+	failedController := mocks.NewMockController[reconcile.Request](mockCtrl)
+	failedController.EXPECT().Start(gomock.Any()).Return(fmt.Errorf("some error"))
+	started := make(chan bool, 1)
+	newController := mocks.NewMockController[reconcile.Request](mockCtrl)
+	newController.EXPECT().Start(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		started <- true
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	streamReconcilerFactory := mocks.NewMockUnmanagedControllerFactory(mockCtrl)
+	gomock.InOrder(
+		streamReconcilerFactory.EXPECT().CreateStreamController(gomock.Any(), gomock.Any(), gomock.Any()).Return(failedController, nil),
+		streamReconcilerFactory.EXPECT().CreateStreamController(gomock.Any(), gomock.Any(), gomock.Any()).Return(newController, nil),
+	)
+	metricsMock := mocks.NewMockStreamClassMetricsReporter(mockCtrl)
+	metricsMock.EXPECT().AddStreamClass(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+	metricsMock.EXPECT().RemoveStreamClass(gomock.Any())
+	metricsMock.EXPECT().ReportControllerRestart(gomock.Any(), gomock.Any())
+	streamReconcilerFactory.EXPECT().ReleaseStreamController(gomock.Any(), gomock.Any(), name).Return(nil)
+	recorder := record.NewFakeRecorder(10)
+
+	reconciler := NewStreamClassReconciler(k8sClient, streamReconcilerFactory, metricsMock, recorder, contracts.FromUnstructured)
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}
+
+	// Start the stream controller and wait for the failure to be recorded
+	_, err := reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+	sc := &v1.StreamClass{}
+	require.Eventually(t, func() bool {
+		require.NoError(t, k8sClient.Get(t.Context(), request.NamespacedName, sc))
+		return sc.Status.Phase == v1.PhaseFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotNil(t, sc.Status.LastControllerFailureTime)
+
+	// Act: the controller is not restarted before the backoff has elapsed
+	result, err := reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+	require.Greater(t, result.RequeueAfter, time.Duration(0))
+	require.LessOrEqual(t, result.RequeueAfter, DefaultRestartBackoff)
+
+	// Act: the controller is restarted after the backoff has elapsed
+	sc.Status.LastControllerFailureTime = &metav1.Time{Time: time.Now().Add(-DefaultRestartBackoff)}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), sc))
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// Assert
+	<-started
+	require.NoError(t, k8sClient.Get(t.Context(), request.NamespacedName, sc))
+	require.Equal(t, v1.PhaseReady, sc.Status.Phase)
+	require.Equal(t, int32(1), sc.Status.ControllerRestarts)
+	condition := meta.FindStatusCondition(sc.Status.Conditions, ControllerConfiguredCondition)
+	require.NotNil(t, condition)
+	require.Equal(t, "ControllerRecovered", condition.Reason)
+}
+
+func Test_RestartBackoff(t *testing.T) {
+	reconciler := NewStreamClassReconciler(nil, nil, nil, nil, nil)
+	for restarts, expected := range map[int32]time.Duration{
+		0:  DefaultRestartBackoff,
+		1:  2 * DefaultRestartBackoff,
+		3:  8 * DefaultRestartBackoff,
+		50: MaxRestartBackoff,
+	} {
+		sc := &v1.StreamClass{Status: v1.StreamClassStatus{ControllerRestarts: restarts}}
+		require.Equal(t, expected, reconciler.restartBackoffFor(sc))
+	}
+}
+
+func Test_Delete_SuspendStreams(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	stream := newSuspendableMockStream("suspended-stream")
+	streamJob := newMockStreamJob(stream)
+	otherJob := newMockStreamJob(newSuspendableMockStream("other-stream"))
+	k8sClient, name := setupFakeClient(t, newDeletedStreamClass(v1.DeletionPolicySuspendStreams), stream, streamJob, otherJob)
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewStreamClassReconciler(k8sClient, mocks.NewMockUnmanagedControllerFactory(mockCtrl), mocks.NewMockStreamClassMetricsReporter(mockCtrl), recorder, contracts.FromUnstructured)
+
+	// Act
+	result, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{}, result)
+
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(stream), stream))
+	require.True(t, stream.Spec.Suspended)
+	err = k8sClient.Get(t.Context(), client.ObjectKeyFromObject(streamJob), &batchv1.Job{})
+	require.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(otherJob), &batchv1.Job{}))
+	err = k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, &v1.StreamClass{})
+	require.True(t, apierrors.IsNotFound(err))
+	require.Contains(t, <-recorder.Events, "StreamClassDeleted")
+}
+
+func Test_Delete_Orphan(t *testing.T) {
+	// Arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	stream := newSuspendableMockStream("orphaned-stream")
+	streamJob := newMockStreamJob(stream)
+	k8sClient, name := setupFakeClient(t, newDeletedStreamClass(v1.DeletionPolicyOrphan), stream, streamJob)
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewStreamClassReconciler(k8sClient, mocks.NewMockUnmanagedControllerFactory(mockCtrl), mocks.NewMockStreamClassMetricsReporter(mockCtrl), recorder, contracts.FromUnstructured)
+
+	// Act
+	_, err := reconciler.Reconcile(t.Context(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(stream), stream))
+	require.False(t, stream.Spec.Suspended)
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(streamJob), &batchv1.Job{}))
+	err = k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, &v1.StreamClass{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func newDeletedStreamClass(policy v1.DeletionPolicy) *v1.StreamClass {
+	return &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Finalizers:        []string{StreamClassFinalizer},
+			DeletionTimestamp: new(metav1.Now()),
+		},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef:    testv1.SchemeGroupVersion.Group,
+			APIVersion:     testv1.SchemeGroupVersion.Version,
+			KindRef:        "MockStreamDefinition",
+			PluralName:     "mockstreamdefinitions",
+			DeletionPolicy: policy,
+		},
+		Status: v1.StreamClassStatus{
+			Phase: v1.PhaseReady,
+		},
+	}
+}
+
+func newSuspendableMockStream(name string) *testv1.MockStreamDefinition {
+	return &testv1.MockStreamDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: testv1.SchemeGroupVersion.String(),
+			Kind:       "MockStreamDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name + "-uid"),
+		},
+		Spec: testv1.MockStreamDefinitionSpec{
+			JobTemplateRef:         corev1.ObjectReference{Name: "job-template"},
+			BackfillJobTemplateRef: corev1.ObjectReference{Name: "backfill-job-template"},
+		},
+	}
+}
+
+// newMockStreamJob creates a job named after the stream and controlled by it
+func newMockStreamJob(stream *testv1.MockStreamDefinition) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            stream.Name,
+			Namespace:       stream.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(stream, testv1.SchemeGroupVersion.WithKind("MockStreamDefinition"))},
+		},
+	}
+}
+
 func expectPhase(t *testing.T, k8sClient client.WithWatch, name string, phase v1.Phase) {
 	sc2 := &v1.StreamClass{}
 	err := k8sClient.Get(t.Context(), types.NamespacedName{Name: name}, sc2)
@@ -322,14 +735,34 @@ func expectPhase(t *testing.T, k8sClient client.WithWatch, name string, phase v1
 	require.Equal(t, phase, sc2.Status.Phase)
 }
 
-func setupFakeClient(t *testing.T, sc *v1.StreamClass) (client.WithWatch, string) {
+func setupFakeClient(t *testing.T, sc *v1.StreamClass, objects ...client.Object) (client.WithWatch, string) {
 	name, err := uuid.NewUUID()
 	require.NoError(t, err)
 	scheme := runtime.NewScheme()
 	_ = v1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = testv1.AddToScheme(scheme)
 
 	sc.Name = name.String()
-	k8sClient := crfake.NewClientBuilder().WithStatusSubresource(&v1.StreamClass{}).WithScheme(scheme).WithObjects(sc).Build()
+	if sc.Spec.KindRef == "" {
+		sc.Spec = v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		}
+	}
+
+	// Only the custom resource definition of the mock stream is installed
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(testv1.SchemeGroupVersion.WithKind("MockStreamDefinition"), meta.RESTScopeNamespace)
+
+	k8sClient := crfake.NewClientBuilder().
+		WithStatusSubresource(&v1.StreamClass{}).
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(append(objects, sc)...).
+		Build()
 	return k8sClient, name.String()
 }
@@ -5,8 +5,28 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-// StreamControllerHandle holds the cancel function and GVK for a stream controller
+// StreamControllerHandle holds the cancel function, GVK and the StreamClass generation for a stream controller.
+// The done channel is closed when the stream controller exits, stopping is set once the operator has cancelled it.
 type StreamControllerHandle struct {
 	cancelFunc context.CancelFunc
 	gvk        schema.GroupVersionKind
+	generation int64
+	done       chan struct{}
+	stopping   bool
+}
+
+// stop cancels the stream controller, the controller has stopped once the done channel is closed
+func (h *StreamControllerHandle) stop() {
+	h.stopping = true
+	h.cancelFunc()
+}
+
+// alive returns true if the stream controller has not exited yet
+func (h *StreamControllerHandle) alive() bool {
+	select {
+	case <-h.done:
+		return false
+	default:
+		return true
+	}
 }
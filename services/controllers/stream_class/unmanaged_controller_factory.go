@@ -13,4 +13,9 @@ type UnmanagedControllerFactory interface {
 
 	// CreateStreamController creates an unmanaged controller for the given GroupVersionKind (GVK).
 	CreateStreamController(ctx context.Context, gvk schema.GroupVersionKind, streamClass *v1.StreamClass) (controller.Controller, error)
+
+	// ReleaseStreamController releases the informers registered by the stream controller of the given stream class.
+	// Informers shared with the stream controllers of other stream classes are kept until they are released as well.
+	// It must be called only after the stream controller has stopped.
+	ReleaseStreamController(ctx context.Context, gvk schema.GroupVersionKind, streamClassName string) error
 }
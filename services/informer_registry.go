@@ -0,0 +1,54 @@
+package services
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// informerRegistry keeps track of the stream classes using the informers registered by the stream controllers.
+// An informer can be shared between stream classes, so it is released only when no stream class uses it anymore.
+type informerRegistry struct {
+	lock   sync.Mutex
+	owners map[schema.GroupVersionKind]map[string]struct{}
+}
+
+func newInformerRegistry() *informerRegistry {
+	return &informerRegistry{
+		owners: make(map[schema.GroupVersionKind]map[string]struct{}),
+	}
+}
+
+// acquire registers the stream class as a user of the informer for the given GVK.
+// Acquiring the same informer multiple times for the same stream class has no effect.
+func (r *informerRegistry) acquire(gvk schema.GroupVersionKind, streamClass string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.owners[gvk]; !ok {
+		r.owners[gvk] = make(map[string]struct{})
+	}
+	r.owners[gvk][streamClass] = struct{}{}
+}
+
+// release unregisters the stream class as a user of the informer for the given GVK.
+// Returns true if no other stream class uses the informer, and it can be removed from the cache.
+func (r *informerRegistry) release(gvk schema.GroupVersionKind, streamClass string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	owners, ok := r.owners[gvk]
+	if !ok {
+		return false
+	}
+	if _, ok := owners[streamClass]; !ok {
+		return false
+	}
+
+	delete(owners, streamClass)
+	if len(owners) > 0 {
+		return false
+	}
+	delete(r.owners, gvk)
+	return true
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+func Test_InformerRegistry_Release_Last_Owner(t *testing.T) {
+	registry := newInformerRegistry()
+	gvk := batchv1.SchemeGroupVersion.WithKind("Job")
+
+	registry.acquire(gvk, "class-a")
+	registry.acquire(gvk, "class-a")
+
+	require.True(t, registry.release(gvk, "class-a"))
+	require.False(t, registry.release(gvk, "class-a"))
+}
+
+func Test_InformerRegistry_Release_Shared(t *testing.T) {
+	registry := newInformerRegistry()
+	gvk := batchv1.SchemeGroupVersion.WithKind("Job")
+
+	registry.acquire(gvk, "class-a")
+	registry.acquire(gvk, "class-b")
+
+	require.False(t, registry.release(gvk, "class-a"))
+	require.False(t, registry.release(gvk, "class-c"))
+	require.True(t, registry.release(gvk, "class-b"))
+}
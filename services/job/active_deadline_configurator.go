@@ -0,0 +1,32 @@
+package job
+
+import (
+	"math"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ Configurator = &activeDeadlineConfigurator{}
+
+// activeDeadlineConfigurator limits the duration of the job by setting its activeDeadlineSeconds.
+// Durations are rounded up to whole seconds, a non-positive duration leaves the job unchanged.
+type activeDeadlineConfigurator struct {
+	duration time.Duration
+}
+
+func (f activeDeadlineConfigurator) ConfigureJob(job *batchv1.Job) error {
+	if f.duration <= 0 {
+		return nil
+	}
+
+	seconds := int64(math.Ceil(f.duration.Seconds()))
+	job.Spec.ActiveDeadlineSeconds = &seconds
+	return nil
+}
+
+func NewActiveDeadlineConfigurator(duration time.Duration) Configurator {
+	return &activeDeadlineConfigurator{
+		duration: duration,
+	}
+}
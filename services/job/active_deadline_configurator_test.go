@@ -0,0 +1,39 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+func Test_ActiveDeadlineConfigurator_Set(t *testing.T) {
+	job := &batchv1.Job{}
+
+	configurator := NewActiveDeadlineConfigurator(2 * time.Hour)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, int64(7200), *job.Spec.ActiveDeadlineSeconds)
+}
+
+func Test_ActiveDeadlineConfigurator_Rounds_Up(t *testing.T) {
+	job := &batchv1.Job{}
+
+	configurator := NewActiveDeadlineConfigurator(1500 * time.Millisecond)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), *job.Spec.ActiveDeadlineSeconds)
+}
+
+func Test_ActiveDeadlineConfigurator_Zero_Duration(t *testing.T) {
+	existing := int64(60)
+	job := &batchv1.Job{}
+	job.Spec.ActiveDeadlineSeconds = &existing
+
+	configurator := NewActiveDeadlineConfigurator(0)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), *job.Spec.ActiveDeadlineSeconds)
+}
@@ -5,9 +5,20 @@ import batchv1 "k8s.io/api/batch/v1"
 // ConfigurationHashAnnotation is the annotation key used to store the configuration hash of a Job.
 const ConfigurationHashAnnotation = "configuration-hash"
 
+// ReferencedSecretsHashAnnotation is the annotation key used to store the hash of the data of the secrets referenced
+// by the stream of a Job.
+const ReferencedSecretsHashAnnotation = "arcane/referenced-secrets-hash"
+
+// PodPolicyHashAnnotation is the annotation key used to store the hash of the pod policy enforced on a Job.
+const PodPolicyHashAnnotation = "arcane/pod-policy-hash"
+
 // BackfillLabel is the label key used to indicate if a Job is a backfill.
 const BackfillLabel = "arcane/backfilling"
 
+// BackfillProgressAnnotation is the annotation key a backfill Job sets on itself to report its progress.
+// The value is a JSON object with the optional fields percent, rows, watermark and updateTime.
+const BackfillProgressAnnotation = "arcane/backfill-progress"
+
 // Configurator defines an interface for configuring Kubernetes Jobs. Each implementer
 // can modify the Job object and chain to the next configurator in the sequence.
 type Configurator interface {
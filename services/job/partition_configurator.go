@@ -0,0 +1,63 @@
+package job
+
+import (
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ Configurator = &partitionConfigurator{}
+
+// partitionConfigurator turns the job into an Indexed Job running one pod per partition in parallel.
+// It adds STREAMCONTEXT__PARTITION_COUNT and STREAMCONTEXT__PARTITION_INDEX environment variables, the latter
+// is resolved from the completion index of the pod. A single partition leaves the job unchanged.
+type partitionConfigurator struct {
+	count int32
+}
+
+func (f partitionConfigurator) ConfigureJob(job *batchv1.Job) error {
+	if f.count <= 1 {
+		return nil
+	}
+
+	completionMode, completions, parallelism := batchv1.IndexedCompletion, f.count, f.count
+	job.Spec.CompletionMode = &completionMode
+	job.Spec.Completions = &completions
+	job.Spec.Parallelism = &parallelism
+
+	for k := range job.Spec.Template.Spec.Containers {
+		container := &job.Spec.Template.Spec.Containers[k]
+		setEnvVar(container, corev1.EnvVar{
+			Name:  "STREAMCONTEXT__PARTITION_COUNT",
+			Value: strconv.Itoa(int(f.count)),
+		})
+		setEnvVar(container, corev1.EnvVar{
+			Name: "STREAMCONTEXT__PARTITION_INDEX",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.annotations['" + batchv1.JobCompletionIndexAnnotation + "']",
+				},
+			},
+		})
+	}
+
+	return nil
+}
+
+// setEnvVar replaces the environment variable of the container with the same name, or appends it.
+func setEnvVar(container *corev1.Container, envVar corev1.EnvVar) {
+	for v := range container.Env {
+		if container.Env[v].Name == envVar.Name {
+			container.Env[v] = envVar
+			return
+		}
+	}
+	container.Env = append(container.Env, envVar)
+}
+
+func NewPartitionConfigurator(count int32) Configurator {
+	return &partitionConfigurator{
+		count: count,
+	}
+}
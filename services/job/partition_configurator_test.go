@@ -0,0 +1,61 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_PartitionConfigurator_Indexed_Job(t *testing.T) {
+	job := &batchv1.Job{}
+	job.Spec.Template.Spec.Containers = []corev1.Container{{Name: "test-container"}}
+
+	configurator := NewPartitionConfigurator(4)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Equal(t, batchv1.IndexedCompletion, *job.Spec.CompletionMode)
+	require.Equal(t, int32(4), *job.Spec.Completions)
+	require.Equal(t, int32(4), *job.Spec.Parallelism)
+
+	env := job.Spec.Template.Spec.Containers[0].Env
+	require.Len(t, env, 2)
+	require.Equal(t, "STREAMCONTEXT__PARTITION_COUNT", env[0].Name)
+	require.Equal(t, "4", env[0].Value)
+	require.Equal(t, "STREAMCONTEXT__PARTITION_INDEX", env[1].Name)
+	require.Equal(t, "metadata.annotations['batch.kubernetes.io/job-completion-index']", env[1].ValueFrom.FieldRef.FieldPath)
+}
+
+func Test_PartitionConfigurator_Update_Existing(t *testing.T) {
+	job := &batchv1.Job{}
+	job.Spec.Template.Spec.Containers = []corev1.Container{{
+		Name: "test-container",
+		Env: []corev1.EnvVar{
+			{Name: "STREAMCONTEXT__PARTITION_COUNT", Value: "2"},
+			{Name: "STREAMCONTEXT__PARTITION_INDEX", Value: "0"},
+		},
+	}}
+
+	configurator := NewPartitionConfigurator(8)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+
+	env := job.Spec.Template.Spec.Containers[0].Env
+	require.Len(t, env, 2)
+	require.Equal(t, "8", env[0].Value)
+	require.Empty(t, env[1].Value)
+	require.NotNil(t, env[1].ValueFrom)
+}
+
+func Test_PartitionConfigurator_Single_Partition(t *testing.T) {
+	job := &batchv1.Job{}
+	job.Spec.Template.Spec.Containers = []corev1.Container{{Name: "test-container"}}
+
+	configurator := NewPartitionConfigurator(1)
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Nil(t, job.Spec.CompletionMode)
+	require.Nil(t, job.Spec.Completions)
+	require.Empty(t, job.Spec.Template.Spec.Containers[0].Env)
+}
@@ -0,0 +1,104 @@
+package job
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+var _ Configurator = &podPolicyConfigurator{}
+
+// PodPolicy holds the settings enforced on the jobs of a stream class.
+type PodPolicy struct {
+	Tolerations        []corev1.Toleration
+	NodeSelector       map[string]string
+	PriorityClassName  string
+	ServiceAccountName string
+	ImagePullSecrets   []corev1.LocalObjectReference
+	Labels             map[string]string
+	Annotations        map[string]string
+}
+
+// Hash returns the hash sum of the pod policy, so the jobs are recreated when the policy changes.
+func (p PodPolicy) Hash() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil { // coverage-ignore
+		return "", err
+	}
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// podPolicyConfigurator applies a pod policy on top of the job template.
+// Tolerations and image pull secrets are added to the ones of the template, node selector entries, labels and
+// annotations replace the entries of the template with the same key, and the priority class and the service account
+// replace the ones of the template when set.
+// Labels and annotations are set on both the job and its pod template. The hash of the policy is recorded in the
+// PodPolicyHashAnnotation of the job.
+type podPolicyConfigurator struct {
+	policy PodPolicy
+}
+
+func (f podPolicyConfigurator) ConfigureJob(job *batchv1.Job) error {
+	podSpec := &job.Spec.Template.Spec
+
+	for _, toleration := range f.policy.Tolerations {
+		if !slices.ContainsFunc(podSpec.Tolerations, func(t corev1.Toleration) bool {
+			return equality.Semantic.DeepEqual(t, toleration)
+		}) {
+			podSpec.Tolerations = append(podSpec.Tolerations, toleration)
+		}
+	}
+
+	for _, secret := range f.policy.ImagePullSecrets {
+		if !slices.Contains(podSpec.ImagePullSecrets, secret) {
+			podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, secret)
+		}
+	}
+
+	podSpec.NodeSelector = mergeMaps(podSpec.NodeSelector, f.policy.NodeSelector)
+
+	if f.policy.PriorityClassName != "" {
+		podSpec.PriorityClassName = f.policy.PriorityClassName
+	}
+
+	if f.policy.ServiceAccountName != "" {
+		podSpec.ServiceAccountName = f.policy.ServiceAccountName
+	}
+
+	job.Labels = mergeMaps(job.Labels, f.policy.Labels)
+	job.Annotations = mergeMaps(job.Annotations, f.policy.Annotations)
+	job.Spec.Template.Labels = mergeMaps(job.Spec.Template.Labels, f.policy.Labels)
+	job.Spec.Template.Annotations = mergeMaps(job.Spec.Template.Annotations, f.policy.Annotations)
+
+	hash, err := f.policy.Hash()
+	if err != nil { // coverage-ignore
+		return err
+	}
+	job.Annotations = mergeMaps(job.Annotations, map[string]string{PodPolicyHashAnnotation: hash})
+	return nil
+}
+
+// mergeMaps copies the entries of source to target, target is created if needed.
+func mergeMaps(target map[string]string, source map[string]string) map[string]string {
+	if len(source) == 0 {
+		return target
+	}
+	if target == nil {
+		target = make(map[string]string, len(source))
+	}
+	maps.Copy(target, source)
+	return target
+}
+
+func NewPodPolicyConfigurator(policy PodPolicy) Configurator {
+	return &podPolicyConfigurator{
+		policy: policy,
+	}
+}
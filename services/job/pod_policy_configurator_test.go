@@ -0,0 +1,81 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_PodPolicyConfigurator_Merges_With_Template(t *testing.T) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"team": "template", "app": "stream"},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Tolerations:        []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+					NodeSelector:       map[string]string{"pool": "default", "zone": "a"},
+					ServiceAccountName: "template-sa",
+					PriorityClassName:  "template-priority",
+					ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "registry"}},
+				},
+			},
+		},
+	}
+
+	configurator := NewPodPolicyConfigurator(PodPolicy{
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpExists},
+			{Key: "streaming", Operator: corev1.TolerationOpEqual, Value: "true", Effect: corev1.TaintEffectNoSchedule},
+		},
+		NodeSelector:       map[string]string{"pool": "streaming"},
+		ServiceAccountName: "policy-sa",
+		ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "registry"}, {Name: "mirror"}},
+		Labels:             map[string]string{"team": "platform"},
+		Annotations:        map[string]string{"cost-center": "data"},
+	})
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+
+	podSpec := job.Spec.Template.Spec
+	require.Len(t, podSpec.Tolerations, 2)
+	require.Equal(t, map[string]string{"pool": "streaming", "zone": "a"}, podSpec.NodeSelector)
+	require.Equal(t, "policy-sa", podSpec.ServiceAccountName)
+	require.Equal(t, "template-priority", podSpec.PriorityClassName)
+	require.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}, {Name: "mirror"}}, podSpec.ImagePullSecrets)
+	require.Equal(t, map[string]string{"team": "platform", "app": "stream"}, job.Labels)
+	require.Equal(t, map[string]string{"team": "platform"}, job.Spec.Template.Labels)
+	require.Equal(t, "data", job.Annotations["cost-center"])
+	require.NotEmpty(t, job.Annotations[PodPolicyHashAnnotation])
+	require.Equal(t, map[string]string{"cost-center": "data"}, job.Spec.Template.Annotations)
+}
+
+func Test_PodPolicyConfigurator_Empty_Policy(t *testing.T) {
+	job := &batchv1.Job{}
+
+	configurator := NewPodPolicyConfigurator(PodPolicy{})
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+
+	hash, err := PodPolicy{}.Hash()
+	require.NoError(t, err)
+	require.Equal(t, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{PodPolicyHashAnnotation: hash}}}, job)
+}
+
+func Test_PodPolicy_Hash(t *testing.T) {
+	policy := PodPolicy{NodeSelector: map[string]string{"pool": "streaming"}}
+
+	hash, err := policy.Hash()
+	require.NoError(t, err)
+	changedHash, err := PodPolicy{NodeSelector: map[string]string{"pool": "batch"}}.Hash()
+	require.NoError(t, err)
+	sameHash, err := PodPolicy{NodeSelector: map[string]string{"pool": "streaming"}}.Hash()
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash, changedHash)
+	require.Equal(t, hash, sameHash)
+}
@@ -0,0 +1,182 @@
+package job
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ Configurator = &referenceInjectionConfigurator{}
+
+// volumeNameHashLength is the length of the hash suffix appended to the names of the injected volumes.
+const volumeNameHashLength = 8
+
+var invalidVolumeNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ReferenceInjection defines how a secret or config map referenced by a field of the stream spec is injected into
+// the containers of a job.
+type ReferenceInjection struct {
+	// FieldName is the name of the field of the stream spec referencing the object
+	FieldName string
+
+	// ConfigMap is true if the field references a config map, false for a secret
+	ConfigMap bool
+
+	// Keys selects the keys to inject, all keys if empty
+	Keys []KeyInjection
+
+	// MountPath mounts the object as a volume at the given path instead of environment variables
+	MountPath string
+
+	// Containers limits the injection to the containers with the given names, all containers if empty
+	Containers []string
+}
+
+// KeyInjection selects a key of a secret or config map and the environment variable it is injected into.
+type KeyInjection struct {
+	Key     string
+	EnvName string
+}
+
+// referenceInjectionConfigurator injects a secret or config map referenced by the stream definition into the
+// containers of the job, either as environment variables or as a read-only volume.
+type referenceInjectionConfigurator struct {
+	injection        ReferenceInjection
+	streamDefinition SecretReferenceProvider
+}
+
+func (s referenceInjectionConfigurator) ConfigureJob(job *batchv1.Job) error {
+	reference, err := s.streamDefinition.GetReferenceForSecret(s.injection.FieldName)
+	if err != nil {
+		return fmt.Errorf("error getting secret reference: %w", err)
+	}
+	if reference.Name == "" {
+		return fmt.Errorf("referenceInjectionConfigurator reference name is empty for field %s", s.injection.FieldName)
+	}
+
+	containers, err := s.targetContainers(job)
+	if err != nil {
+		return err
+	}
+
+	if s.injection.MountPath != "" {
+		volumeName := s.volumeName()
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, s.volume(volumeName, *reference))
+		for _, container := range containers {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: s.injection.MountPath,
+				ReadOnly:  true,
+			})
+		}
+		return nil
+	}
+
+	for _, container := range containers {
+		if len(s.injection.Keys) == 0 {
+			container.EnvFrom = append(container.EnvFrom, s.envFromSource(*reference))
+			continue
+		}
+		for _, key := range s.injection.Keys {
+			container.Env = append(container.Env, s.envVar(*reference, key))
+		}
+	}
+	return nil
+}
+
+// targetContainers returns the containers of the job the object is injected into.
+func (s referenceInjectionConfigurator) targetContainers(job *batchv1.Job) ([]*corev1.Container, error) {
+	var containers []*corev1.Container
+	for i := range job.Spec.Template.Spec.Containers {
+		container := &job.Spec.Template.Spec.Containers[i]
+		if len(s.injection.Containers) == 0 || slices.Contains(s.injection.Containers, container.Name) {
+			containers = append(containers, container)
+		}
+	}
+
+	for _, name := range s.injection.Containers {
+		if !slices.ContainsFunc(containers, func(c *corev1.Container) bool { return c.Name == name }) {
+			return nil, fmt.Errorf("container %s not found in the job template", name)
+		}
+	}
+	return containers, nil
+}
+
+// volumeName returns a DNS label derived from the name of the field referencing the object. Characters not allowed
+// in a DNS label are replaced by dashes and a hash of the field name is appended so that fields differing only in
+// case or in invalid characters do not collide.
+func (s referenceInjectionConfigurator) volumeName() string {
+	sum := md5.Sum([]byte(s.injection.FieldName))
+	hash := hex.EncodeToString(sum[:])[:volumeNameHashLength]
+
+	prefix := strings.Trim(invalidVolumeNameCharacters.ReplaceAllString("ref-"+strings.ToLower(s.injection.FieldName), "-"), "-")
+	if maxLength := validation.DNS1123LabelMaxLength - volumeNameHashLength - 1; len(prefix) > maxLength {
+		prefix = strings.TrimRight(prefix[:maxLength], "-")
+	}
+	return fmt.Sprintf("%s-%s", prefix, hash)
+}
+
+func (s referenceInjectionConfigurator) volume(name string, reference corev1.LocalObjectReference) corev1.Volume {
+	var items []corev1.KeyToPath
+	for _, key := range s.injection.Keys {
+		items = append(items, corev1.KeyToPath{Key: key.Key, Path: key.Key})
+	}
+
+	if s.injection.ConfigMap {
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: reference, Items: items},
+			},
+		}
+	}
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: reference.Name, Items: items},
+		},
+	}
+}
+
+func (s referenceInjectionConfigurator) envFromSource(reference corev1.LocalObjectReference) corev1.EnvFromSource {
+	if s.injection.ConfigMap {
+		return corev1.EnvFromSource{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: reference}}
+	}
+	return corev1.EnvFromSource{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: reference}}
+}
+
+func (s referenceInjectionConfigurator) envVar(reference corev1.LocalObjectReference, key KeyInjection) corev1.EnvVar {
+	name := key.EnvName
+	if name == "" {
+		name = key.Key
+	}
+
+	if s.injection.ConfigMap {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: reference, Key: key.Key},
+			},
+		}
+	}
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: reference, Key: key.Key},
+		},
+	}
+}
+
+func NewReferenceInjectionConfigurator(injection ReferenceInjection, sd SecretReferenceProvider) Configurator {
+	return &referenceInjectionConfigurator{
+		injection:        injection,
+		streamDefinition: sd,
+	}
+}
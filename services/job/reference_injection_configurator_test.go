@@ -0,0 +1,153 @@
+package job
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SneaksAndData/arcane-operator/tests/mocks/job_mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func newReferenceInjectionTestJob() *batchv1.Job {
+	return &batchv1.Job{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "main", Image: "test-image:latest"},
+						{Name: "sidecar", Image: "sidecar-image:latest"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReferenceProvider(t *testing.T, fieldName string, name string) SecretReferenceProvider {
+	mockCtrl := gomock.NewController(t)
+	mockProvider := job_mock.NewMockSecretReferenceProvider(mockCtrl)
+	mockProvider.EXPECT().GetReferenceForSecret(fieldName).Return(&corev1.LocalObjectReference{Name: name}, nil)
+	return mockProvider
+}
+
+func Test_ReferenceInjectionConfigurator_EnvFrom_Secret(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{FieldName: "connectionRef"}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "connectionRef", "my-secret")).ConfigureJob(job)
+	require.NoError(t, err)
+	for _, container := range job.Spec.Template.Spec.Containers {
+		require.Len(t, container.EnvFrom, 1)
+		require.NotNil(t, container.EnvFrom[0].SecretRef)
+		require.Equal(t, "my-secret", container.EnvFrom[0].SecretRef.Name)
+	}
+}
+
+func Test_ReferenceInjectionConfigurator_EnvFrom_ConfigMap(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{FieldName: "settingsRef", ConfigMap: true}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "settingsRef", "my-config")).ConfigureJob(job)
+	require.NoError(t, err)
+	require.Len(t, job.Spec.Template.Spec.Containers[0].EnvFrom, 1)
+	require.NotNil(t, job.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef)
+	require.Equal(t, "my-config", job.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name)
+}
+
+func Test_ReferenceInjectionConfigurator_Keys_With_Target_Container(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{
+		FieldName:  "connectionRef",
+		Keys:       []KeyInjection{{Key: "password", EnvName: "DB_PASSWORD"}, {Key: "USER"}},
+		Containers: []string{"main"},
+	}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "connectionRef", "my-secret")).ConfigureJob(job)
+	require.NoError(t, err)
+
+	main := job.Spec.Template.Spec.Containers[0]
+	require.Empty(t, main.EnvFrom)
+	require.Len(t, main.Env, 2)
+	require.Equal(t, "DB_PASSWORD", main.Env[0].Name)
+	require.Equal(t, "my-secret", main.Env[0].ValueFrom.SecretKeyRef.Name)
+	require.Equal(t, "password", main.Env[0].ValueFrom.SecretKeyRef.Key)
+	require.Equal(t, "USER", main.Env[1].Name)
+	require.Equal(t, "USER", main.Env[1].ValueFrom.SecretKeyRef.Key)
+
+	require.Empty(t, job.Spec.Template.Spec.Containers[1].Env)
+}
+
+func Test_ReferenceInjectionConfigurator_Mount_Secret(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{
+		FieldName: "certificateRef",
+		Keys:      []KeyInjection{{Key: "tls.crt"}},
+		MountPath: "/etc/certs",
+	}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "certificateRef", "my-cert")).ConfigureJob(job)
+	require.NoError(t, err)
+
+	require.Len(t, job.Spec.Template.Spec.Volumes, 1)
+	volume := job.Spec.Template.Spec.Volumes[0]
+	require.Equal(t, "ref-certificateref-e3c713c2", volume.Name)
+	require.NotNil(t, volume.Secret)
+	require.Equal(t, "my-cert", volume.Secret.SecretName)
+	require.Equal(t, []corev1.KeyToPath{{Key: "tls.crt", Path: "tls.crt"}}, volume.Secret.Items)
+
+	for _, container := range job.Spec.Template.Spec.Containers {
+		require.Empty(t, container.Env)
+		require.Len(t, container.VolumeMounts, 1)
+		require.Equal(t, "ref-certificateref-e3c713c2", container.VolumeMounts[0].Name)
+		require.Equal(t, "/etc/certs", container.VolumeMounts[0].MountPath)
+		require.True(t, container.VolumeMounts[0].ReadOnly)
+	}
+}
+
+func Test_ReferenceInjectionConfigurator_Mount_ConfigMap(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{FieldName: "settingsRef", ConfigMap: true, MountPath: "/etc/settings"}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "settingsRef", "my-config")).ConfigureJob(job)
+	require.NoError(t, err)
+	require.Len(t, job.Spec.Template.Spec.Volumes, 1)
+	require.NotNil(t, job.Spec.Template.Spec.Volumes[0].ConfigMap)
+	require.Equal(t, "my-config", job.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	require.Nil(t, job.Spec.Template.Spec.Volumes[0].ConfigMap.Items)
+}
+
+func Test_ReferenceInjectionConfigurator_Unknown_Container(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{FieldName: "connectionRef", Containers: []string{"missing"}}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "connectionRef", "my-secret")).ConfigureJob(job)
+	require.Error(t, err)
+}
+
+func Test_ReferenceInjectionConfigurator_Empty_Reference(t *testing.T) {
+	job := newReferenceInjectionTestJob()
+	injection := ReferenceInjection{FieldName: "connectionRef"}
+
+	err := NewReferenceInjectionConfigurator(injection, newReferenceProvider(t, "connectionRef", "")).ConfigureJob(job)
+	require.Error(t, err)
+}
+
+func Test_ReferenceInjectionConfigurator_Volume_Name(t *testing.T) {
+	// Arrange
+	names := map[string]bool{}
+	fieldNames := []string{"certificateRef", "CertificateRef", "certificate_ref", "certificate.ref", strings.Repeat("longFieldName", 10)}
+
+	for _, fieldName := range fieldNames {
+		// Act
+		name := referenceInjectionConfigurator{injection: ReferenceInjection{FieldName: fieldName}}.volumeName()
+
+		// Assert
+		require.Empty(t, validation.IsDNS1123Label(name), "volume name %s is not a DNS label", name)
+		require.False(t, names[name], "volume name %s is not unique", name)
+		names[name] = true
+	}
+}
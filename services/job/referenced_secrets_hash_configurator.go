@@ -0,0 +1,33 @@
+package job
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ Configurator = (*ReferencedSecretsHashConfigurator)(nil)
+
+// ReferencedSecretsHashConfigurator sets the referenced secrets hash annotation on a job.
+type ReferencedSecretsHashConfigurator struct {
+	secretsHash string
+}
+
+// ConfigureJob sets the referenced secrets hash annotation on the job, an empty hash leaves the job unchanged.
+func (c *ReferencedSecretsHashConfigurator) ConfigureJob(job *batchv1.Job) error {
+	if c.secretsHash == "" {
+		return nil
+	}
+
+	if job.Annotations == nil {
+		job.Annotations = make(map[string]string)
+	}
+
+	job.Annotations[ReferencedSecretsHashAnnotation] = c.secretsHash
+	return nil
+}
+
+// NewReferencedSecretsHashConfigurator creates a new ReferencedSecretsHashConfigurator.
+func NewReferencedSecretsHashConfigurator(secretsHash string) *ReferencedSecretsHashConfigurator {
+	return &ReferencedSecretsHashConfigurator{
+		secretsHash: secretsHash,
+	}
+}
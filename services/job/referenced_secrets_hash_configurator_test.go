@@ -0,0 +1,40 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+func Test_ReferencedSecretsHashConfigurator_Annotations_Set(t *testing.T) {
+	job := &batchv1.Job{}
+
+	configurator := NewReferencedSecretsHashConfigurator("abc123")
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Equal(t, "abc123", job.Annotations[ReferencedSecretsHashAnnotation])
+}
+
+func Test_ReferencedSecretsHashConfigurator_Annotations_EmptyHash(t *testing.T) {
+	job := &batchv1.Job{}
+
+	configurator := NewReferencedSecretsHashConfigurator("")
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.NotContains(t, job.Annotations, ReferencedSecretsHashAnnotation)
+}
+
+func Test_ReferencedSecretsHashConfigurator_Annotations_Preserve_Other(t *testing.T) {
+	job := &batchv1.Job{}
+	job.Annotations = map[string]string{
+		ConfigurationHashAnnotation: "configuration",
+	}
+
+	configurator := NewReferencedSecretsHashConfigurator("xyz789")
+	err := configurator.ConfigureJob(job)
+	require.NoError(t, err)
+	require.Equal(t, "xyz789", job.Annotations[ReferencedSecretsHashAnnotation])
+	require.Equal(t, "configuration", job.Annotations[ConfigurationHashAnnotation])
+}
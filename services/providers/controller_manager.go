@@ -2,17 +2,34 @@ package providers
 
 import (
 	"github.com/SneaksAndData/arcane-operator/config"
+	corev1 "k8s.io/api/core/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
 func ControllerManager(kubeconfig *rest.Config, appConfig *config.AppConfig, scheme *apiruntime.Scheme) (controllerruntime.Manager, error) { // coverage-ignore (should be tested in integration tests)
-	return controllerruntime.NewManager(kubeconfig, controllerruntime.Options{
+	options := controllerruntime.Options{
 		Metrics: metricsserver.Options{
 			BindAddress: appConfig.Telemetry.MetricsBindAddress,
 		},
 		Scheme: scheme,
-	})
+		// The secrets and config maps are read directly from the API server, the stream controllers only watch the
+		// metadata of secrets, so the data of all secrets and config maps in the cluster is not held in the cache
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}},
+		},
+	}
+
+	if appConfig.Webhook.Enabled {
+		options.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    appConfig.Webhook.Port,
+			CertDir: appConfig.Webhook.CertDir,
+		})
+	}
+
+	return controllerruntime.NewManager(kubeconfig, options)
 }
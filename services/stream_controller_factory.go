@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
@@ -9,6 +11,7 @@ import (
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend/empty"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream/backend/job"
 	"github.com/SneaksAndData/arcane-operator/services/controllers/stream_class"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +27,8 @@ type streamControllerFactory struct {
 	manager          manager.Manager
 	eventRecorder    record.EventRecorder
 	definitionParser stream.DefinitionParser
+	informers        *informerRegistry
+	controllerConfig stream.ControllerConfig
 }
 
 func (s streamControllerFactory) CreateStreamController(_ context.Context, gvk schema.GroupVersionKind, streamClass *v1.StreamClass) (controller.Controller, error) { // coverage-ignore (trivial)
@@ -34,18 +39,57 @@ func (s streamControllerFactory) CreateStreamController(_ context.Context, gvk s
 		stream.CronJob:   cron_job.NewCronJobBackend(s.client, s.jobBuilder, s.eventRecorder, statusManager),
 		stream.NoBackend: empty.NewEmptyBackend(s.eventRecorder),
 	}
-	streamReconciler := stream.NewStreamReconciler(s.client, gvk, s.jobBuilder, streamClass, s.eventRecorder, s.definitionParser, backends, backfillBackend)
+	scope, err := stream.NewStreamClassScope(s.client, streamClass)
+	if err != nil {
+		return nil, err
+	}
+	streamReconciler := stream.NewStreamReconciler(s.client, gvk, s.jobBuilder, streamClass, s.eventRecorder, s.definitionParser, backends, backfillBackend, s.controllerConfig.ForStreamClass(streamClass), scope)
 	unmanaged, err := streamReconciler.SetupUnmanaged(s.manager.GetCache(), s.manager.GetScheme(), s.manager.GetRESTMapper())
-	return unmanaged, err
+	if err != nil {
+		return nil, err
+	}
+
+	for informerGvk := range streamControllerInformers(gvk) {
+		s.informers.acquire(informerGvk, streamClass.Name)
+	}
+	return unmanaged, nil
+}
+
+func (s streamControllerFactory) ReleaseStreamController(ctx context.Context, gvk schema.GroupVersionKind, streamClassName string) error { // coverage-ignore (trivial)
+	var errs []error
+	for informerGvk, obj := range streamControllerInformers(gvk) {
+		if !s.informers.release(informerGvk, streamClassName) {
+			continue
+		}
+		err := s.manager.GetCache().RemoveInformer(ctx, obj)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove informer for %s: %w", informerGvk, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// streamControllerInformers returns the objects watched by a stream controller for the given stream GVK that can be
+// released with the controller. Only the streams are included: the informers of jobs, cron jobs, backfill requests,
+// namespaces and service accounts are shared with the cached client of the manager, which reads them outside the
+// stream controllers, and the metadata informer of secrets is shared by all stream controllers.
+func streamControllerInformers(gvk schema.GroupVersionKind) map[schema.GroupVersionKind]client.Object { // coverage-ignore (trivial)
+	streamObject := &unstructured.Unstructured{}
+	streamObject.SetGroupVersionKind(gvk)
+	return map[schema.GroupVersionKind]client.Object{
+		gvk: streamObject,
+	}
 }
 
 // NewStreamControllerFactory creates a new instance of StreamControllerFactory
-func NewStreamControllerFactory(client client.Client, jobBuilder stream.JobBuilder, manager manager.Manager, eventRecorder record.EventRecorder, definitionParser stream.DefinitionParser) stream_class.UnmanagedControllerFactory { // coverage-ignore (trivial)
+func NewStreamControllerFactory(client client.Client, jobBuilder stream.JobBuilder, manager manager.Manager, eventRecorder record.EventRecorder, definitionParser stream.DefinitionParser, controllerConfig stream.ControllerConfig) stream_class.UnmanagedControllerFactory { // coverage-ignore (trivial)
 	return &streamControllerFactory{
 		client:           client,
 		jobBuilder:       jobBuilder,
 		manager:          manager,
 		eventRecorder:    eventRecorder,
 		definitionParser: definitionParser,
+		informers:        newInformerRegistry(),
+		controllerConfig: controllerConfig,
 	}
 }
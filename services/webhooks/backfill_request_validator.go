@@ -0,0 +1,210 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/stream"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ admission.CustomValidator = (*BackfillRequestValidator)(nil)
+
+// BackfillRequestValidator rejects backfill requests that cannot be picked up by any stream controller and approvals
+// of backfill requests by users that are not allowed to approve them.
+type BackfillRequestValidator struct {
+	client           client.Client
+	definitionParser stream.DefinitionParser
+}
+
+func NewBackfillRequestValidator(client client.Client, definitionParser stream.DefinitionParser) *BackfillRequestValidator {
+	return &BackfillRequestValidator{
+		client:           client,
+		definitionParser: definitionParser,
+	}
+}
+
+func (v *BackfillRequestValidator) SetupWithManager(mgr ctrl.Manager) error { // coverage-ignore (should be tested in e2e)
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1.BackfillRequest{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *BackfillRequestValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	request, ok := obj.(*v1.BackfillRequest)
+	if !ok { // coverage-ignore
+		return nil, fmt.Errorf("expected a BackfillRequest but got %T", obj)
+	}
+
+	logger := klog.FromContext(ctx).WithValues("backfillRequest", client.ObjectKeyFromObject(request))
+	logger.V(2).Info("validating backfill request")
+
+	// A backfill is approved by annotating an existing request, so the requester cannot approve it upfront.
+	if request.ApprovedBy() != "" {
+		return nil, fmt.Errorf("the %s annotation cannot be set when the backfill request is created", v1.BackfillApprovedByAnnotation)
+	}
+
+	sc := &v1.StreamClass{}
+	err := v.client.Get(ctx, types.NamespacedName{Name: request.Spec.StreamClass}, sc)
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("stream class %s does not exist", request.Spec.StreamClass)
+	}
+	if err != nil { // coverage-ignore
+		return nil, fmt.Errorf("failed to get stream class %s: %w", request.Spec.StreamClass, err)
+	}
+
+	name := types.NamespacedName{Namespace: request.Namespace, Name: request.Spec.StreamId}
+	definition, err := stream.GetStreamForClass(ctx, v.client, sc, name, v.definitionParser)
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("stream %s of kind %s does not exist", name, sc.Spec.KindRef)
+	}
+	if errors.Is(err, stream.ErrStreamOutOfScope) {
+		return nil, fmt.Errorf("stream %s is not selected by the namespace and stream selectors of stream class %s", name, sc.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream %s of kind %s: %w", name, sc.Spec.KindRef, err)
+	}
+
+	active, err := v.getActiveRequest(ctx, request)
+	if err != nil { // coverage-ignore
+		return nil, fmt.Errorf("failed to list backfill requests: %w", err)
+	}
+	if active != nil {
+		return nil, fmt.Errorf("backfill request %s for stream %s is already active", active.Name, name)
+	}
+
+	var warnings admission.Warnings
+	if definition.Suspended() {
+		warnings = append(warnings, fmt.Sprintf("stream %s is suspended, the backfill will start once it is resumed", name))
+	}
+	return warnings, nil
+}
+
+func (v *BackfillRequestValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRequest, ok := oldObj.(*v1.BackfillRequest)
+	if !ok { // coverage-ignore
+		return nil, fmt.Errorf("expected a BackfillRequest but got %T", oldObj)
+	}
+	newRequest, ok := newObj.(*v1.BackfillRequest)
+	if !ok { // coverage-ignore
+		return nil, fmt.Errorf("expected a BackfillRequest but got %T", newObj)
+	}
+
+	if oldRequest.Spec.StreamId != newRequest.Spec.StreamId {
+		return nil, fmt.Errorf("spec.streamId is immutable")
+	}
+	if oldRequest.Spec.StreamClass != newRequest.Spec.StreamClass {
+		return nil, fmt.Errorf("spec.streamClass is immutable")
+	}
+	if approver := newRequest.ApprovedBy(); approver != "" && approver != oldRequest.ApprovedBy() {
+		return nil, v.authorizeApproval(ctx, newRequest, approver)
+	}
+	if approver := newRequest.Status.ApprovedBy; approver != "" && approver != oldRequest.Status.ApprovedBy {
+		return nil, v.authorizeStatusApproval(ctx, newRequest, approver)
+	}
+	return nil, nil
+}
+
+func (v *BackfillRequestValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) { // coverage-ignore (trivial)
+	return nil, nil
+}
+
+// authorizeApproval checks that the approval annotation holds the name of the user approving the backfill request and
+// that the user is allowed to approve backfill requests in the namespace of the request.
+func (v *BackfillRequestValidator) authorizeApproval(ctx context.Context, request *v1.BackfillRequest, approver string) error {
+	admissionRequest, err := admission.RequestFromContext(ctx)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("unable to identify the approver: %w", err)
+	}
+
+	userInfo := admissionRequest.UserInfo
+	if userInfo.Username != approver {
+		return fmt.Errorf("the %s annotation must be set to the name of the approving user %s", v1.BackfillApprovedByAnnotation, userInfo.Username)
+	}
+
+	allowed, err := v.canApprove(ctx, userInfo, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to check the permissions of %s: %w", approver, err)
+	}
+	if !allowed {
+		return fmt.Errorf("user %s is not allowed to approve backfill requests in namespace %s", approver, request.Namespace)
+	}
+	return nil
+}
+
+// authorizeStatusApproval checks that the user recording an approval in the status of the backfill request is allowed
+// to approve backfill requests in the namespace of the request. The operator records the approvals of the annotation,
+// so the user writing the status is not necessarily the approver.
+func (v *BackfillRequestValidator) authorizeStatusApproval(ctx context.Context, request *v1.BackfillRequest, approver string) error {
+	admissionRequest, err := admission.RequestFromContext(ctx)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("unable to identify the user recording the approval: %w", err)
+	}
+
+	userInfo := admissionRequest.UserInfo
+	allowed, err := v.canApprove(ctx, userInfo, request)
+	if err != nil { // coverage-ignore
+		return fmt.Errorf("failed to check the permissions of %s: %w", userInfo.Username, err)
+	}
+	if !allowed {
+		return fmt.Errorf("user %s is not allowed to record the approval of %s for backfill requests in namespace %s",
+			userInfo.Username, approver, request.Namespace)
+	}
+	return nil
+}
+
+// canApprove runs a subject access review checking that the user is allowed to approve the backfill request.
+func (v *BackfillRequestValidator) canApprove(ctx context.Context, userInfo authenticationv1.UserInfo, request *v1.BackfillRequest) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+	for key, value := range userInfo.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			UID:    userInfo.UID,
+			Groups: userInfo.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: request.Namespace,
+				Verb:      v1.BackfillApproveVerb,
+				Group:     v1.SchemeGroupVersion.Group,
+				Resource:  "backfillrequests",
+				Name:      request.Name,
+			},
+		},
+	}
+	err := v.client.Create(ctx, review)
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// getActiveRequest returns another not completed backfill request for the same stream, if any.
+func (v *BackfillRequestValidator) getActiveRequest(ctx context.Context, request *v1.BackfillRequest) (*v1.BackfillRequest, error) {
+	requests := &v1.BackfillRequestList{}
+	err := v.client.List(ctx, requests, client.InNamespace(request.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range requests.Items {
+		other := &requests.Items[i]
+		if other.Name != request.Name && other.Spec.StreamId == request.Spec.StreamId && !other.Spec.Completed {
+			return other, nil
+		}
+	}
+	return nil, nil
+}
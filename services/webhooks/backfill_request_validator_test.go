@@ -0,0 +1,293 @@
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/SneaksAndData/arcane-operator/pkg/apis/streaming/v1"
+	testv1 "github.com/SneaksAndData/arcane-operator/pkg/test/apis_test/streaming/v1"
+	"github.com/SneaksAndData/arcane-operator/services/controllers/contracts"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func Test_ValidateCreate_Valid_Request(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t, newStream("stream1", false)), contracts.FromUnstructured)
+
+	// Act
+	warnings, err := validator.ValidateCreate(t.Context(), newRequest("backfill1", "stream-class", "stream1", false))
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func Test_ValidateCreate_StreamClass_Not_Found(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t, newStream("stream1", false)), contracts.FromUnstructured)
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), newRequest("backfill1", "unknown-class", "stream1", false))
+
+	// Assert
+	require.ErrorContains(t, err, "stream class unknown-class does not exist")
+}
+
+func Test_ValidateCreate_Stream_Not_Found(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t, newStream("stream1", false)), contracts.FromUnstructured)
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), newRequest("backfill1", "stream-class", "stream2", false))
+
+	// Assert
+	require.ErrorContains(t, err, "stream default/stream2 of kind MockStreamDefinition does not exist")
+}
+
+func Test_ValidateCreate_Stream_Out_Of_Scope(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, newStream("stream1", false))
+	sc := &v1.StreamClass{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: "stream-class"}, sc))
+	sc.Spec.StreamSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "orders"}}
+	require.NoError(t, k8sClient.Update(t.Context(), sc))
+	validator := NewBackfillRequestValidator(k8sClient, contracts.FromUnstructured)
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), newRequest("backfill1", "stream-class", "stream1", false))
+
+	// Assert
+	require.ErrorContains(t, err, "stream default/stream1 is not selected by the namespace and stream selectors of stream class stream-class")
+}
+
+func Test_ValidateCreate_Duplicate_Of_Active_Request(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, newStream("stream1", false), newRequest("backfill1", "stream-class", "stream1", false))
+	validator := NewBackfillRequestValidator(k8sClient, contracts.FromUnstructured)
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), newRequest("backfill2", "stream-class", "stream1", false))
+
+	// Assert
+	require.ErrorContains(t, err, "backfill request backfill1 for stream default/stream1 is already active")
+}
+
+func Test_ValidateCreate_Completed_Request_Is_Not_Duplicate(t *testing.T) {
+	// Arrange
+	k8sClient := setupFakeClient(t, newStream("stream1", false), newRequest("backfill1", "stream-class", "stream1", true))
+	validator := NewBackfillRequestValidator(k8sClient, contracts.FromUnstructured)
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), newRequest("backfill2", "stream-class", "stream1", false))
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func Test_ValidateCreate_Suspended_Stream(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t, newStream("stream1", true)), contracts.FromUnstructured)
+
+	// Act
+	warnings, err := validator.ValidateCreate(t.Context(), newRequest("backfill1", "stream-class", "stream1", false))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "is suspended")
+}
+
+func Test_ValidateUpdate_Immutable_Fields(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t), contracts.FromUnstructured)
+	oldRequest := newRequest("backfill1", "stream-class", "stream1", false)
+
+	// Act
+	_, streamIdErr := validator.ValidateUpdate(t.Context(), oldRequest, newRequest("backfill1", "stream-class", "stream2", false))
+	_, streamClassErr := validator.ValidateUpdate(t.Context(), oldRequest, newRequest("backfill1", "other-class", "stream1", false))
+	_, completedErr := validator.ValidateUpdate(t.Context(), oldRequest, newRequest("backfill1", "stream-class", "stream1", true))
+
+	// Assert
+	require.ErrorContains(t, streamIdErr, "spec.streamId is immutable")
+	require.ErrorContains(t, streamClassErr, "spec.streamClass is immutable")
+	require.NoError(t, completedErr)
+}
+
+func Test_ValidateCreate_Approval_Annotation_Rejected(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupFakeClient(t, newStream("stream1", false)), contracts.FromUnstructured)
+	request := newRequest("backfill1", "stream-class", "stream1", false)
+	request.Annotations = map[string]string{v1.BackfillApprovedByAnnotation: "jane.doe"}
+
+	// Act
+	_, err := validator.ValidateCreate(t.Context(), request)
+
+	// Assert
+	require.ErrorContains(t, err, "cannot be set when the backfill request is created")
+}
+
+func Test_ValidateUpdate_Approval(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		approver string
+		err      string
+	}{
+		{name: "allowed approver", user: "jane.doe", approver: "jane.doe"},
+		{name: "approver is not the user", user: "john.doe", approver: "jane.doe", err: "must be set to the name of the approving user john.doe"},
+		{name: "user is not allowed to approve", user: "john.doe", approver: "john.doe", err: "user john.doe is not allowed to approve"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			validator := NewBackfillRequestValidator(setupAuthorizingClient(t, "jane.doe"), contracts.FromUnstructured)
+			oldRequest := newRequest("backfill1", "stream-class", "stream1", false)
+			updatedRequest := newRequest("backfill1", "stream-class", "stream1", false)
+			updatedRequest.Annotations = map[string]string{v1.BackfillApprovedByAnnotation: tt.approver}
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: tt.user},
+			}})
+
+			// Act
+			_, err := validator.ValidateUpdate(ctx, oldRequest, updatedRequest)
+
+			// Assert
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func Test_ValidateUpdate_Unchanged_Approval(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupAuthorizingClient(t, "jane.doe"), contracts.FromUnstructured)
+	oldRequest := newRequest("backfill1", "stream-class", "stream1", false)
+	oldRequest.Annotations = map[string]string{v1.BackfillApprovedByAnnotation: "jane.doe"}
+	updatedRequest := oldRequest.DeepCopy()
+	updatedRequest.Spec.Cancel = true
+
+	// Act
+	_, err := validator.ValidateUpdate(t.Context(), oldRequest, updatedRequest)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func Test_ValidateUpdate_Status_Approval(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		approver string
+		err      string
+	}{
+		{name: "allowed user", user: "jane.doe", approver: "jane.doe"},
+		{name: "allowed user records the approval of another user", user: "jane.doe", approver: "john.doe"},
+		{name: "user is not allowed to approve", user: "john.doe", approver: "john.doe", err: "user john.doe is not allowed to record the approval"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			validator := NewBackfillRequestValidator(setupAuthorizingClient(t, "jane.doe"), contracts.FromUnstructured)
+			oldRequest := newRequest("backfill1", "stream-class", "stream1", false)
+			updatedRequest := oldRequest.DeepCopy()
+			updatedRequest.Status.ApprovedBy = tt.approver
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo:    authenticationv1.UserInfo{Username: tt.user},
+				SubResource: "status",
+			}})
+
+			// Act
+			_, err := validator.ValidateUpdate(ctx, oldRequest, updatedRequest)
+
+			// Assert
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func Test_ValidateUpdate_Unchanged_Status_Approval(t *testing.T) {
+	// Arrange
+	validator := NewBackfillRequestValidator(setupAuthorizingClient(t, "jane.doe"), contracts.FromUnstructured)
+	oldRequest := newRequest("backfill1", "stream-class", "stream1", false)
+	oldRequest.Status.ApprovedBy = "jane.doe"
+	updatedRequest := oldRequest.DeepCopy()
+	updatedRequest.Status.Phase = v1.BackfillRequestPhaseRunning
+
+	// Act
+	_, err := validator.ValidateUpdate(t.Context(), oldRequest, updatedRequest)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func newStream(name string, suspended bool) client.Object {
+	return &testv1.MockStreamDefinition{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       testv1.MockStreamDefinitionSpec{Suspended: suspended},
+	}
+}
+
+func newRequest(name, streamClass, streamId string, completed bool) *v1.BackfillRequest {
+	return &v1.BackfillRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec: v1.BackfillRequestSpec{
+			StreamClass: streamClass,
+			StreamId:    streamId,
+			Completed:   completed,
+		},
+	}
+}
+
+func setupFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, testv1.AddToScheme(scheme))
+
+	sc := &v1.StreamClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "stream-class"},
+		Spec: v1.StreamClassSpec{
+			APIGroupRef: testv1.SchemeGroupVersion.Group,
+			APIVersion:  testv1.SchemeGroupVersion.Version,
+			KindRef:     "MockStreamDefinition",
+			PluralName:  "mockstreamdefinitions",
+		},
+	}
+
+	return crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objects, sc)...).
+		Build()
+}
+
+// setupAuthorizingClient returns a fake client whose subject access reviews allow only the given approver to approve
+// backfill requests.
+func setupAuthorizingClient(t *testing.T, approver string) client.Client {
+	return interceptor.NewClient(setupFakeClient(t).(client.WithWatch), interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if review, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+				attributes := review.Spec.ResourceAttributes
+				review.Status.Allowed = review.Spec.User == approver && attributes.Verb == v1.BackfillApproveVerb &&
+					attributes.Resource == "backfillrequests" && attributes.Namespace == "default"
+				return nil
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	})
+}
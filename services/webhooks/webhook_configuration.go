@@ -0,0 +1,13 @@
+package webhooks
+
+// WebhookConfig holds the configuration of the admission webhook server.
+type WebhookConfig struct {
+	// Enabled indicates whether the admission webhook server should be started.
+	Enabled bool `mapstructure:"enabled,omitempty"`
+
+	// Port is the port the admission webhook server listens on.
+	Port int `mapstructure:"port,omitempty"`
+
+	// CertDir is the directory that contains the TLS certificate and key of the admission webhook server.
+	CertDir string `mapstructure:"cert-dir,omitempty"`
+}
@@ -35,6 +35,10 @@ func (d *PeriodicMetricsReporter) AddStreamClass(kind string, metricName string,
 	}
 }
 
+func (d *PeriodicMetricsReporter) ReportControllerRestart(kind string, tags map[string]string) { // coverage-ignore (should be tested in integration tests)
+	Increment(d.client, "stream_class_controller_restart", tags)
+}
+
 // RunPeriodicMetricsReporter starts the metrics reporting loop for stream classes.
 // It reports a metric for each registered stream class at regular intervals.
 // When context is cancelled, the reporting loop exits.
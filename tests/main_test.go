@@ -160,14 +160,14 @@ func createManager(ctx context.Context, g *errgroup.Group) (manager.Manager, err
 	eventBroadcaster.StartLogging(klog.Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientSet.CoreV1().Events("")})
 	eventRecorder := eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "Arcane-Operator-Test"})
-	controllerFactory := services.NewStreamControllerFactory(mgr.GetClient(), jobBuilder, mgr, eventRecorder, contracts.FromUnstructured)
+	controllerFactory := services.NewStreamControllerFactory(mgr.GetClient(), jobBuilder, mgr, eventRecorder, contracts.FromUnstructured, stream.ControllerConfig{})
 
 	reporter := telemetry.NewPeriodicMetricsReporter(telemetry.GetClient(ctx), &telemetry.PeriodicMetricsReporterConfig{
 		ReportInterval: 1 * time.Minute,
 		InitialDelay:   1 * time.Minute,
 	})
 	// We don't start the reporter here, as we don't need metrics for the tests.
-	err = stream_class.NewStreamClassReconciler(mgr.GetClient(), controllerFactory, reporter, eventRecorder).SetupWithManager(mgr)
+	err = stream_class.NewStreamClassReconciler(mgr.GetClient(), controllerFactory, reporter, eventRecorder, contracts.FromUnstructured).SetupWithManager(mgr)
 	if err != nil {
 		return nil, fmt.Errorf("unable to setup StreamClassReconciler: %w", err)
 	}
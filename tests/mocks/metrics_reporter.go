@@ -51,6 +51,18 @@ func (mr *MockStreamClassMetricsReporterMockRecorder) AddStreamClass(kind, metri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStreamClass", reflect.TypeOf((*MockStreamClassMetricsReporter)(nil).AddStreamClass), kind, metricName, tags)
 }
 
+// ReportControllerRestart mocks base method.
+func (m *MockStreamClassMetricsReporter) ReportControllerRestart(kind string, tags map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportControllerRestart", kind, tags)
+}
+
+// ReportControllerRestart indicates an expected call of ReportControllerRestart.
+func (mr *MockStreamClassMetricsReporterMockRecorder) ReportControllerRestart(kind, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportControllerRestart", reflect.TypeOf((*MockStreamClassMetricsReporter)(nil).ReportControllerRestart), kind, tags)
+}
+
 // RemoveStreamClass mocks base method.
 func (m *MockStreamClassMetricsReporter) RemoveStreamClass(kind string) {
 	m.ctrl.T.Helper()
@@ -57,3 +57,17 @@ func (mr *MockUnmanagedControllerFactoryMockRecorder) CreateStreamController(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamController", reflect.TypeOf((*MockUnmanagedControllerFactory)(nil).CreateStreamController), ctx, gvk, streamClass)
 }
+
+// ReleaseStreamController mocks base method.
+func (m *MockUnmanagedControllerFactory) ReleaseStreamController(ctx context.Context, gvk schema.GroupVersionKind, streamClassName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseStreamController", ctx, gvk, streamClassName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseStreamController indicates an expected call of ReleaseStreamController.
+func (mr *MockUnmanagedControllerFactoryMockRecorder) ReleaseStreamController(ctx, gvk, streamClassName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseStreamController", reflect.TypeOf((*MockUnmanagedControllerFactory)(nil).ReleaseStreamController), ctx, gvk, streamClassName)
+}